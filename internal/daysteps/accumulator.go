@@ -0,0 +1,65 @@
+package daysteps
+
+import (
+	"fmt"
+	"time"
+)
+
+// DayAccumulator собирает пакеты данных об активности за один день,
+// например "678,0h50m" и "1205,1h15m", которые присылает браслет.
+// Нулевое значение готово к работе.
+type DayAccumulator struct {
+	packages int
+	steps    int
+	duration time.Duration
+}
+
+// AddSteps разбирает пакет data так же, как DayActionInfo, и добавляет
+// его шаги и продолжительность к итогам дня. При ошибке итоги не меняются.
+func (a *DayAccumulator) AddSteps(data string) error {
+	steps, d, err := parsePackage(data)
+	if err != nil {
+		return fmt.Errorf("parsePackage: %w", err)
+	}
+
+	a.packages++
+	a.steps += steps
+	a.duration += d
+
+	return nil
+}
+
+// Reset очищает итоги, например перед началом нового дня.
+func (a *DayAccumulator) Reset() {
+	*a = DayAccumulator{}
+}
+
+// Info возвращает итоги дня в формате:
+//
+//	Пакетов за день: 2.
+//	Количество шагов: 1883.
+//	Длительность: 2.08 ч.
+//	Дистанция составила 1.48 км.
+//	Вы сожгли 55.61 ккал.
+//
+// Дистанция и калории считаются по суммарным шагам и продолжительности,
+// а не складываются из значений отдельных пакетов. Если вес или рост
+// некорректны, ошибка записывается в лог и возвращается пустая строка.
+func (a *DayAccumulator) Info(weight, height float64) string {
+	var stats DayStats
+
+	if a.packages > 0 {
+		var err error
+
+		stats, err = statsFor(a.steps, a.duration, weight, height, 0)
+		if err != nil {
+			currentLogger().Printf("%v", err)
+			return ""
+		}
+	}
+
+	return fmt.Sprintf(
+		"Пакетов за день: %d.\nКоличество шагов: %d.\nДлительность: %.2f ч.\nДистанция составила %.2f км.\nВы сожгли %.2f ккал.\n",
+		a.packages, stats.Steps, stats.Duration.Hours(), stats.DistanceKm, stats.Calories,
+	)
+}
@@ -0,0 +1,47 @@
+package daysteps
+
+import (
+	"bytes"
+	"log"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+func (suite *DayStepsTestSuite) TestDayAccumulator() {
+	var a DayAccumulator
+
+	assert.Equal(suite.T(), "Пакетов за день: 0.\nКоличество шагов: 0.\nДлительность: 0.00 ч.\nДистанция составила 0.00 км.\nВы сожгли 0.00 ккал.\n", a.Info(75.0, 1.75))
+
+	assert.NoError(suite.T(), a.AddSteps("678,0h50m"))
+	assert.NoError(suite.T(), a.AddSteps("1205,1h15m"))
+
+	err := a.AddSteps("abc,1h")
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrBadDataFormat)
+
+	// итоги совпадают с одним пакетом на сумму шагов и времени.
+	total, err := DayActionStats("1883,2h05m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	want := "Пакетов за день: 2.\nКоличество шагов: 1883.\nДлительность: 2.08 ч.\nДистанция составила 1.48 км.\nВы сожгли 55.61 ккал.\n"
+	assert.Equal(suite.T(), want, a.Info(75.0, 1.75))
+	assert.Contains(suite.T(), a.Info(75.0, 1.75), "Дистанция составила "+spentcalories.DefaultFormat.FormatFloat(total.DistanceKm)+" км.\n")
+	assert.Contains(suite.T(), a.Info(75.0, 1.75), "Вы сожгли "+spentcalories.DefaultFormat.FormatFloat(total.Calories)+" ккал.\n")
+
+	a.Reset()
+	assert.NoError(suite.T(), a.AddSteps("3000,30m"))
+	assert.Contains(suite.T(), a.Info(75.0, 1.75), "Пакетов за день: 1.\nКоличество шагов: 3000.\n")
+}
+
+func (suite *DayStepsTestSuite) TestDayAccumulatorInfoError() {
+	var buf bytes.Buffer
+	SetLogger(log.New(&buf, "", 0))
+	defer SetLogger(nil)
+
+	var a DayAccumulator
+	assert.NoError(suite.T(), a.AddSteps("678,0h50m"))
+
+	assert.Empty(suite.T(), a.Info(0, 1.75))
+	assert.Contains(suite.T(), buf.String(), spentcalories.ErrNonPositiveWeight.Error())
+}
@@ -0,0 +1,80 @@
+package daysteps
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// ReadDayActions принимает:
+// r io.Reader — источник записей об активности, по одной на строку.
+// weight, height float64 — вес (кг.) и рост (м.) пользователя.
+//
+// Пустые строки и строки, начинающиеся с '#', пропускаются.
+// Ошибка в одной строке не прерывает обработку остальных.
+//
+// Возвращает:
+// []DayStats — данные из успешно разобранных строк.
+// error — объединенные через errors.Join ошибки с номерами строк или nil.
+func ReadDayActions(r io.Reader, weight, height float64) ([]DayStats, error) {
+	var (
+		stats []DayStats
+		errs  []error
+	)
+
+	err := spentcalories.ScanLines(r, func(n int, line string) {
+		s, err := dayStats(line, weight, height, 0)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", n, err))
+			return
+		}
+
+		stats = append(stats, s)
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return stats, errors.Join(errs...)
+}
+
+// ProcessReader принимает:
+// r io.Reader — источник записей об активности, по одной на строку.
+// weight, height float64 — вес (кг.) и рост (м.) пользователя.
+// skipErrors bool — пропускать ли строки с ошибками.
+// fn func(DayStats) error — функция, которая вызывается для каждой разобранной строки.
+//
+// В отличие от ReadDayActions, строки не накапливаются в памяти, поэтому
+// ProcessReader подходит для больших файлов. Пустые строки и строки,
+// начинающиеся с '#', пропускаются. Если skipErrors равен false, обработка
+// останавливается на первой ошибочной строке, иначе ошибки собираются
+// и возвращаются в конце. Ошибка fn всегда останавливает обработку
+// и возвращается вместе с ошибками пропущенных строк.
+//
+// Возвращает:
+// error — ошибку строки, fn или чтения из r либо nil.
+func ProcessReader(r io.Reader, weight, height float64, skipErrors bool, fn func(DayStats) error) error {
+	var errs []error
+
+	err := spentcalories.ScanLinesErr(r, func(n int, line string) error {
+		s, err := dayStats(line, weight, height, 0)
+		if err != nil {
+			err = fmt.Errorf("line %d: %w", n, err)
+			if !skipErrors {
+				return err
+			}
+
+			errs = append(errs, err)
+			return nil
+		}
+
+		return fn(s)
+	})
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
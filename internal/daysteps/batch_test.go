@@ -0,0 +1,62 @@
+package daysteps
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+func (suite *DayStepsTestSuite) TestReadDayActions() {
+	data := "# прогулки за неделю\n678,0h50m\n\n-5,1h\n1000,30m\n"
+
+	stats, err := ReadDayActions(strings.NewReader(data), 75.0, 1.75)
+
+	assert.Len(suite.T(), stats, 2)
+	assert.Equal(suite.T(), 678, stats[0].Steps)
+	assert.Equal(suite.T(), 50*time.Minute, stats[0].Duration)
+	assert.Equal(suite.T(), 1000, stats[1].Steps)
+
+	assert.ErrorContains(suite.T(), err, "line 4:")
+	assert.True(suite.T(), errors.Is(err, spentcalories.ErrNonPositiveSteps))
+}
+
+func (suite *DayStepsTestSuite) TestProcessReader() {
+	data := "# прогулки за неделю\n678,0h50m\n\n-5,1h\n1000,30m\n"
+
+	var stats []DayStats
+	collect := func(s DayStats) error {
+		stats = append(stats, s)
+		return nil
+	}
+
+	// без пропуска ошибок обработка останавливается на строке 4.
+	err := ProcessReader(strings.NewReader(data), 75.0, 1.75, false, collect)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrNonPositiveSteps)
+	assert.ErrorContains(suite.T(), err, "line 4:")
+	assert.Len(suite.T(), stats, 1)
+
+	// с пропуском ошибок результат совпадает с ReadDayActions.
+	want, wantErr := ReadDayActions(strings.NewReader(data), 75.0, 1.75)
+
+	stats = nil
+	err = ProcessReader(strings.NewReader(data), 75.0, 1.75, true, collect)
+	assert.Equal(suite.T(), want, stats)
+	assert.EqualError(suite.T(), err, wantErr.Error())
+
+	// ошибка функции останавливает обработку.
+	errStop := errors.New("stop")
+	calls := 0
+	err = ProcessReader(strings.NewReader("678,0h50m\n1000,30m\n"), 75.0, 1.75, true, func(DayStats) error {
+		calls++
+		return errStop
+	})
+	assert.ErrorIs(suite.T(), err, errStop)
+	assert.Equal(suite.T(), 1, calls)
+
+	err = ProcessReader(strings.NewReader(""), 75.0, 1.75, false, collect)
+	assert.NoError(suite.T(), err)
+}
@@ -5,81 +5,336 @@
 package daysteps
 
 import (
-	"errors"
 	"fmt"
 	"log"
 	"strconv"
-	"strings"
+	"sync"
 	"time"
 
 	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
 )
 
-const (
-	// Длина одного шага в метрах
-	stepLength = 0.65
-	// Количество метров в одном километре
-	mInKm = 1000
+// Logger — получатель сообщений об ошибках DayActionInfo.
+// Ему удовлетворяет *log.Logger.
+type Logger interface {
+	Printf(format string, v ...any)
+}
+
+var (
+	loggerMu sync.RWMutex
+
+	// logger — текущий получатель сообщений об ошибках, см. SetLogger.
+	logger Logger = log.Default()
 )
 
+// SetLogger задает получателя сообщений об ошибках DayActionInfo
+// и DayActionInfoWithStep. По умолчанию это стандартный логгер пакета log;
+// nil возвращает его обратно. SetLogger можно вызывать одновременно
+// с DayActionInfo из разных горутин.
+func SetLogger(l Logger) {
+	if l == nil {
+		l = log.Default()
+	}
+
+	loggerMu.Lock()
+	defer loggerMu.Unlock()
+
+	logger = l
+}
+
+// currentLogger возвращает получателя сообщений, заданного SetLogger.
+func currentLogger() Logger {
+	loggerMu.RLock()
+	defer loggerMu.RUnlock()
+
+	return logger
+}
+
 // parsePackage парсит строку формата "678,0h50m",
 // в которой 678 - шаги, 0h50m - продолжительность.
+// Продолжительность можно указать и в формате "0:50:00", см. spentcalories.ParseDuration.
+// Поля можно разделять и точкой с запятой, пробелы вокруг полей и BOM
+// в начале строки допускаются, см. spentcalories.SplitRecord.
 //
 // Возвращает:
 // int — количество шагов
 // time.Duration — продолжительность прогулки.
-// error — ошибку, если что-то пошло не так.
+// error — ошибку, если что-то пошло не так: spentcalories.ErrBadDataFormat
+// для некорректного формата или ErrNonPositive* для некорректных значений.
 func parsePackage(data string) (int, time.Duration, error) {
-	parts := strings.Split(data, ",")
+	parts := spentcalories.SplitRecord(data)
 	if len(parts) != 2 {
-		return 0, 0, errors.New("bad data format")
+		return 0, 0, spentcalories.ErrBadDataFormat
 	}
 
 	steps, err := strconv.Atoi(parts[0])
 	if err != nil {
-		return 0, 0, fmt.Errorf("failed to extract steps: %w", err)
+		return 0, 0, &packageError{msg: "failed to extract steps", kind: spentcalories.ErrBadDataFormat, err: err}
 	}
 
 	if steps <= 0 {
-		return 0, 0, errors.New("steps must be positive")
+		return 0, 0, &packageError{msg: "steps must be positive", kind: spentcalories.ErrNonPositiveSteps}
 	}
 
-	d, err := time.ParseDuration(parts[1])
+	d, err := spentcalories.ParseDuration(parts[1])
 	if err != nil {
-		return 0, 0, fmt.Errorf("failed to extract duration: %w", err)
+		return 0, 0, &packageError{msg: "failed to extract duration", kind: spentcalories.ErrBadDataFormat, err: err}
 	}
 
 	if d <= 0 {
-		return 0, 0, errors.New("duration is not positive")
+		return 0, 0, spentcalories.ErrNonPositiveDuration
 	}
 
 	return steps, d, nil
 }
 
+// packageError — ошибка parsePackage. Текст ошибки — msg и, если есть,
+// причина err, как и до появления ошибок spentcalories, а errors.Is
+// находит и вид ошибки kind, например spentcalories.ErrBadDataFormat, и err.
+type packageError struct {
+	msg  string
+	kind error
+	err  error
+}
+
+func (e *packageError) Error() string {
+	if e.err == nil {
+		return e.msg
+	}
+
+	return e.msg + ": " + e.err.Error()
+}
+
+func (e *packageError) Unwrap() []error {
+	if e.err == nil {
+		return []error{e.kind}
+	}
+
+	return []error{e.kind, e.err}
+}
+
+// ParsePackage проверяет и разбирает строку формата "678,0h50m"
+// так же, как DayActionInfo, но без расчета дистанции и калорий.
+// Пригодится, чтобы сразу сообщить пользователю о некорректном вводе.
+//
+// Возвращает:
+// int — количество шагов.
+// time.Duration — продолжительность прогулки.
+// error — spentcalories.ErrBadDataFormat или ErrNonPositive* для некорректной строки.
+func ParsePackage(data string) (int, time.Duration, error) {
+	return parsePackage(data)
+}
+
 // DayActionInfo вычисляет дистанцию в километрах и количество потраченных калорий,
 // возвращает отформатированную строку с данными.
+//
+// Длина шага рассчитывается по росту так же, как в пакете spentcalories,
+// поэтому дистанция совпадает с дистанцией той же прогулки, записанной как тренировка.
 func DayActionInfo(data string, weight, height float64) string {
-	steps, d, err := parsePackage(data)
+	return DayActionInfoWithStep(data, weight, height, 0)
+}
+
+// DayActionInfoWithStep — то же, что DayActionInfo, но дистанция считается
+// по длине шага пользователя stepLen в метрах, а калории — по этой дистанции.
+// Если stepLen равна нулю, длина шага рассчитывается по росту, как
+// в spentcalories.StepLength.
+// Отрицательная длина шага считается ошибкой.
+func DayActionInfoWithStep(data string, weight, height, stepLen float64) string {
+	info, err := dayActionInfo(data, weight, height, stepLen)
 	if err != nil {
-		log.Printf("parsePackage: %v", err)
+		currentLogger().Printf("%v", err)
 		return ""
 	}
 
-	if steps <= 0 {
-		return ""
+	return info
+}
+
+// DayActionInfoErr — то же, что DayActionInfo, но вместо записи в лог
+// возвращает ошибку, чтобы вызывающий код мог отличить некорректные данные
+// от отсутствия активности.
+func DayActionInfoErr(data string, weight, height float64) (string, error) {
+	return dayActionInfo(data, weight, height, 0)
+}
+
+// mInKm — количество метров в километре.
+const mInKm = 1000
+
+// DayStats — данные об активности, рассчитанные по одной строке.
+type DayStats struct {
+	Steps          int           // количество шагов.
+	Duration       time.Duration // продолжительность прогулки.
+	DistanceMeters float64       // дистанция в метрах.
+	DistanceKm     float64       // дистанция в километрах.
+	Calories       float64       // количество потраченных калорий.
+}
+
+// DayActionStats — то же, что DayActionInfoErr, но возвращает данные
+// об активности в виде DayStats, в том числе дистанцию в метрах, которую
+// удобнее показывать для коротких прогулок, чем "0.07 км.".
+func DayActionStats(data string, weight, height float64) (DayStats, error) {
+	return dayStats(data, weight, height, 0)
+}
+
+// String возвращает данные об активности в формате DayActionInfo.
+func (s DayStats) String() string {
+	return s.Format(spentcalories.DefaultFormat)
+}
+
+// Format — то же, что String, но числа выводятся в формате opts.
+func (s DayStats) Format(opts spentcalories.FormatOptions) string {
+	return s.report(spentcalories.ReportOptions{Format: opts})
+}
+
+// report возвращает данные об активности по настройкам r. Скорость
+// в отчете не выводится, поэтому r.HideSpeed ни на что не влияет.
+func (s DayStats) report(r spentcalories.ReportOptions) string {
+	opts := r.Format
+
+	if r.Compact {
+		if r.HideDistance {
+			return fmt.Sprintf("Шагов: %d, %s ккал\n", s.Steps, opts.FormatFloat(s.Calories))
+		}
+
+		return fmt.Sprintf("Шагов: %d, %s км., %s ккал\n", s.Steps, opts.FormatFloat(s.DistanceKm), opts.FormatFloat(s.Calories))
+	}
+
+	info := fmt.Sprintf("Количество шагов: %d.\n", s.Steps)
+	if !r.HideDistance {
+		info += fmt.Sprintf("Дистанция составила %s км.\n", opts.FormatFloat(s.DistanceKm))
+	}
+
+	return info + fmt.Sprintf("Вы сожгли %s ккал.\n", opts.FormatFloat(s.Calories))
+}
+
+// DayActionInfoOpts — то же, что DayActionInfoErr, но отчет настраивается
+// через те же опции, что и spentcalories.TrainingInfoOpts:
+//
+//	DayActionInfoOpts(data, weight, height, spentcalories.WithPrecision(1))
+//
+// Без opts вывод совпадает с DayActionInfo.
+func DayActionInfoOpts(data string, weight, height float64, opts ...spentcalories.Option) (string, error) {
+	r, err := spentcalories.NewReportOptions(opts...)
+	if err != nil {
+		return "", err
 	}
 
-	meters := float64(steps) * stepLength
-	kilometers := meters / mInKm
+	stats, err := dayStats(data, weight, height, 0)
+	if err != nil {
+		return "", err
+	}
 
-	calories, err := spentcalories.WalkingSpentCalories(steps, weight, height, d)
+	return stats.report(r), nil
+}
+
+// DayActionInfoFormat — то же, что DayActionInfoErr, но числа выводятся
+// в формате opts, например spentcalories.FormatOptions{Decimals: 1, DecimalSep: ","}.
+func DayActionInfoFormat(data string, weight, height float64, opts spentcalories.FormatOptions) (string, error) {
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+
+	stats, err := dayStats(data, weight, height, 0)
 	if err != nil {
-		log.Printf("WalkingSpentCalories: %v", err)
-		return ""
+		return "", err
+	}
+
+	return stats.Format(opts), nil
+}
+
+// dayActionInfo вычисляет дистанцию и калории для шагов длиной stepLen
+// и возвращает отформатированную строку или обернутую ошибку.
+func dayActionInfo(data string, weight, height, stepLen float64) (string, error) {
+	stats, err := dayStats(data, weight, height, stepLen)
+	if err != nil {
+		return "", err
+	}
+
+	return stats.String(), nil
+}
+
+// DayActionInfoFor — то же, что DayActionInfoErr, но вес и рост берутся
+// из профиля p, а калории умножаются на p.CalorieFactor(). Если пол
+// не указан, результат совпадает с DayActionInfoErr.
+func DayActionInfoFor(data string, p spentcalories.UserProfile) (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", fmt.Errorf("invalid profile: %w", err)
+	}
+
+	factor, err := p.CalorieFactor()
+	if err != nil {
+		return "", err
+	}
+
+	stats, err := dayStats(data, float64(p.Weight), float64(p.Height), 0)
+	if err != nil {
+		return "", err
+	}
+
+	stats.Calories *= factor
+
+	return stats.String(), nil
+}
+
+// dayStats разбирает строку data и рассчитывает по ней DayStats
+// для шагов длиной stepLen.
+func dayStats(data string, weight, height, stepLen float64) (DayStats, error) {
+	if stepLen < 0 {
+		return DayStats{}, fmt.Errorf("step length is negative: %v", stepLen)
+	}
+
+	steps, d, err := parsePackage(data)
+	if err != nil {
+		return DayStats{}, fmt.Errorf("parsePackage: %w", err)
+	}
+
+	return statsFor(steps, d, weight, height, stepLen)
+}
+
+// checkHeight проверяет рост так же, как spentcalories.TrainingInfo:
+// неположительный рост дает spentcalories.ErrNonPositiveHeight, а рост
+// вне диапазона spentcalories.ValidateHeight, например в сантиметрах, —
+// *spentcalories.HeightError.
+func checkHeight(height float64) error {
+	if height <= 0 {
+		return fmt.Errorf("%w: %v", spentcalories.ErrNonPositiveHeight, height)
+	}
+
+	if _, err := spentcalories.ValidateHeight(height); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// statsFor рассчитывает DayStats для steps шагов длиной stepLen
+// за время d. Если задана длина шага, калории считаются по той же
+// дистанции, что выводится пользователю, а не по длине шага из роста.
+func statsFor(steps int, d time.Duration, weight, height, stepLen float64) (DayStats, error) {
+	if err := checkHeight(height); err != nil {
+		return DayStats{}, err
+	}
+
+	calories, err := spentcalories.WalkingCalories(steps, spentcalories.Kilograms(weight), spentcalories.Metres(height), d)
+	if err != nil {
+		return DayStats{}, fmt.Errorf("WalkingSpentCalories: %w", err)
+	}
+
+	distanceKm := spentcalories.DistanceWithStep(steps, height, stepLen)
+
+	if stepLen > 0 {
+		byDistance, err := spentcalories.WalkingSpentCaloriesByDistance(distanceKm, weight, d)
+		if err != nil {
+			return DayStats{}, fmt.Errorf("WalkingSpentCaloriesByDistance: %w", err)
+		}
+
+		calories = spentcalories.Kcal(byDistance)
 	}
 
-	return fmt.Sprintf(
-		"Количество шагов: %d.\nДистанция составила %.2f км.\nВы сожгли %.2f ккал.\n",
-		steps, kilometers, calories,
-	)
+	return DayStats{
+		Steps:          steps,
+		Duration:       d,
+		DistanceMeters: distanceKm * mInKm,
+		DistanceKm:     distanceKm,
+		Calories:       float64(calories),
+	}, nil
 }
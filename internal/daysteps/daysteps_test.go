@@ -2,13 +2,20 @@ package daysteps
 
 import (
 	"bytes"
+	"errors"
+	"fmt"
+	"io"
 	"log"
 	"os"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
 )
 
 type DayStepsTestSuite struct {
@@ -78,6 +85,20 @@ func (suite *DayStepsTestSuite) TestParsePackage() {
 			wantDuration: 30*time.Minute + 30*time.Second,
 			wantErr:      false,
 		},
+		{
+			name:         "продолжительность - часы, минуты и секунды через двоеточие",
+			input:        "1000,1:30:00",
+			wantSteps:    1000,
+			wantDuration: 90 * time.Minute,
+			wantErr:      false,
+		},
+		{
+			name:         "продолжительность - минуты и секунды через двоеточие",
+			input:        "1000,45:00",
+			wantSteps:    1000,
+			wantDuration: 45 * time.Minute,
+			wantErr:      false,
+		},
 		// Ошибки формата
 		{
 			name:         "неверный формат - неправильное количество параметров",
@@ -137,18 +158,18 @@ func (suite *DayStepsTestSuite) TestParsePackage() {
 			wantErr:      true,
 		},
 		{
-			name:         "неверные шаги - пробелы в начале",
+			name:         "пробелы вокруг шагов - пробелы в начале",
 			input:        " 12345,1h30m",
-			wantSteps:    0,
-			wantDuration: 0,
-			wantErr:      true,
+			wantSteps:    12345,
+			wantDuration: time.Hour + 30*time.Minute,
+			wantErr:      false,
 		},
 		{
-			name:         "неверные шаги - пробелы в конце",
+			name:         "пробелы вокруг шагов - пробелы в конце",
 			input:        "12345 ,1h30m",
-			wantSteps:    0,
-			wantDuration: 0,
-			wantErr:      true,
+			wantSteps:    12345,
+			wantDuration: time.Hour + 30*time.Minute,
+			wantErr:      false,
 		},
 		{
 			name:         "неверные шаги - некорректные символы",
@@ -244,7 +265,7 @@ func (suite *DayStepsTestSuite) TestDayActionInfo() {
 			input:         "6000,1h00m",
 			weight:        75.0,
 			height:        1.75,
-			want:          "Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 177.19 ккал.\n",
+			want:          "Количество шагов: 6000.\nДистанция составила 4.72 км.\nВы сожгли 177.19 ккал.\n",
 			wantLogOutput: false,
 		},
 		{
@@ -252,7 +273,7 @@ func (suite *DayStepsTestSuite) TestDayActionInfo() {
 			input:         "3000,30m",
 			weight:        75.0,
 			height:        1.75,
-			want:          "Количество шагов: 3000.\nДистанция составила 1.95 км.\nВы сожгли 88.59 ккал.\n",
+			want:          "Количество шагов: 3000.\nДистанция составила 2.36 км.\nВы сожгли 88.59 ккал.\n",
 			wantLogOutput: false,
 		},
 		{
@@ -260,7 +281,7 @@ func (suite *DayStepsTestSuite) TestDayActionInfo() {
 			input:         "20000,1h00m",
 			weight:        75.0,
 			height:        1.75,
-			want:          "Количество шагов: 20000.\nДистанция составила 13.00 км.\nВы сожгли 590.62 ккал.\n",
+			want:          "Количество шагов: 20000.\nДистанция составила 15.75 км.\nВы сожгли 590.62 ккал.\n",
 			wantLogOutput: false,
 		},
 		{
@@ -268,7 +289,7 @@ func (suite *DayStepsTestSuite) TestDayActionInfo() {
 			input:         "1000,2h00m",
 			weight:        75.0,
 			height:        1.75,
-			want:          "Количество шагов: 1000.\nДистанция составила 0.65 км.\nВы сожгли 29.53 ккал.\n",
+			want:          "Количество шагов: 1000.\nДистанция составила 0.79 км.\nВы сожгли 29.53 ккал.\n",
 			wantLogOutput: false,
 		},
 		{
@@ -276,7 +297,7 @@ func (suite *DayStepsTestSuite) TestDayActionInfo() {
 			input:         "6000,1h00m",
 			weight:        60.0,
 			height:        1.85,
-			want:          "Количество шагов: 6000.\nДистанция составила 3.90 км.\nВы сожгли 149.85 ккал.\n",
+			want:          "Количество шагов: 6000.\nДистанция составила 5.00 км.\nВы сожгли 149.85 ккал.\n",
 			wantLogOutput: false,
 		},
 		{
@@ -346,3 +367,419 @@ func (suite *DayStepsTestSuite) TestDayActionInfo() {
 		})
 	}
 }
+
+func (suite *DayStepsTestSuite) TestDayActionInfoWithStep() {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+
+	defer log.SetOutput(os.Stderr)
+
+	tests := []struct {
+		name          string
+		input         string
+		stepLen       float64
+		want          string
+		wantLogOutput bool
+	}{
+		{
+			name:    "длина шага пользователя",
+			input:   "6000,1h00m",
+			stepLen: 0.8,
+			want:    "Количество шагов: 6000.\nДистанция составила 4.80 км.\nВы сожгли 180.00 ккал.\n",
+		},
+		{
+			name:    "калории по дистанции с длиной шага пользователя",
+			input:   "6000,1h00m",
+			stepLen: 0.9,
+			want:    "Количество шагов: 6000.\nДистанция составила 5.40 км.\nВы сожгли 202.50 ккал.\n",
+		},
+		{
+			name:    "нулевая длина шага - длина по росту",
+			input:   "6000,1h00m",
+			stepLen: 0,
+			want:    "Количество шагов: 6000.\nДистанция составила 4.72 км.\nВы сожгли 177.19 ккал.\n",
+		},
+		{
+			name:          "отрицательная длина шага",
+			input:         "6000,1h00m",
+			stepLen:       -0.7,
+			want:          "",
+			wantLogOutput: true,
+		},
+		{
+			name:          "некорректный формат",
+			input:         "not valid",
+			stepLen:       0.8,
+			want:          "",
+			wantLogOutput: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			buf.Reset()
+
+			got := DayActionInfoWithStep(tt.input, 75.0, 1.75, tt.stepLen)
+			assert.Equal(suite.T(), tt.want, got)
+
+			if tt.wantLogOutput {
+				assert.NotEmpty(suite.T(), buf.String())
+			} else {
+				assert.Empty(suite.T(), buf.String())
+			}
+		})
+	}
+
+	assert.Equal(suite.T(), DayActionInfo("6000,1h00m", 75.0, 1.75), DayActionInfoWithStep("6000,1h00m", 75.0, 1.75, 0))
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoDistanceMatchesTraining() {
+	tests := []struct {
+		steps  int
+		height float64
+	}{
+		{steps: 678, height: 1.87},
+		{steps: 6000, height: 1.75},
+		{steps: 6000, height: 1.55},
+		{steps: 15392, height: 2.05},
+	}
+
+	for _, tt := range tests {
+		suite.Run(fmt.Sprintf("%d шагов, рост %.2f", tt.steps, tt.height), func() {
+			training, err := spentcalories.ParseTrainingData(fmt.Sprintf("%d,Ходьба,1h00m", tt.steps), 75.0, tt.height)
+			assert.NoError(suite.T(), err)
+
+			got := DayActionInfo(fmt.Sprintf("%d,1h00m", tt.steps), 75.0, tt.height)
+			assert.Contains(suite.T(), got, fmt.Sprintf("Дистанция составила %.2f км.", training.Distance))
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoErr() {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+
+	defer log.SetOutput(os.Stderr)
+
+	got, err := DayActionInfoErr("6000,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), DayActionInfo("6000,1h00m", 75.0, 1.75), got)
+
+	got, err = DayActionInfoErr("not valid", 75.0, 1.75)
+	assert.ErrorContains(suite.T(), err, "parsePackage")
+	assert.Empty(suite.T(), got)
+
+	got, err = DayActionInfoErr("6000,1h00m", 0, 1.75)
+	assert.ErrorContains(suite.T(), err, "WalkingSpentCalories")
+	assert.True(suite.T(), errors.Is(err, spentcalories.ErrNonPositiveWeight))
+	assert.Empty(suite.T(), got)
+
+	assert.Empty(suite.T(), buf.String(), "DayActionInfoErr не должна писать в лог")
+}
+
+func (suite *DayStepsTestSuite) TestParsePackageSentinelErrors() {
+	tests := []struct {
+		input   string
+		wantErr error
+		wantMsg string
+	}{
+		{input: "678", wantErr: spentcalories.ErrBadDataFormat, wantMsg: "bad data format"},
+		{input: "abc,1h30m", wantErr: spentcalories.ErrBadDataFormat, wantMsg: "failed to extract steps"},
+		{input: "678,invalid", wantErr: spentcalories.ErrBadDataFormat, wantMsg: "failed to extract duration"},
+		{input: "6000,-0:30", wantErr: spentcalories.ErrBadDataFormat, wantMsg: "failed to extract duration"},
+		{input: "0,1h30m", wantErr: spentcalories.ErrNonPositiveSteps, wantMsg: "steps must be positive"},
+		{input: "678,0h0m", wantErr: spentcalories.ErrNonPositiveDuration, wantMsg: "duration is not positive"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.input, func() {
+			_, _, err := parsePackage(tt.input)
+			assert.True(suite.T(), errors.Is(err, tt.wantErr), "ожидалась ошибка %v, получено %v", tt.wantErr, err)
+			assert.True(suite.T(), strings.HasPrefix(err.Error(), tt.wantMsg), "ожидался текст %q, получено %q", tt.wantMsg, err)
+
+			_, err = DayActionInfoErr(tt.input, 75.0, 1.75)
+			assert.True(suite.T(), errors.Is(err, tt.wantErr), "ожидалась ошибка %v, получено %v", tt.wantErr, err)
+		})
+	}
+
+	_, err := DayActionInfoErr("678,1h", 75.0, -1)
+	assert.True(suite.T(), errors.Is(err, spentcalories.ErrNonPositiveHeight))
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoHeight() {
+	var buf bytes.Buffer
+	log.SetOutput(&buf)
+
+	defer log.SetOutput(os.Stderr)
+
+	// рост проверяется так же, как в spentcalories.TrainingInfo.
+	_, wantErr := spentcalories.TrainingInfo("6000,Ходьба,1h", 75.0, 175)
+
+	_, err := DayActionInfoErr("6000,1h", 75.0, 175)
+	var heightErr *spentcalories.HeightError
+	assert.ErrorAs(suite.T(), err, &heightErr)
+	assert.ErrorContains(suite.T(), wantErr, err.Error())
+
+	assert.Empty(suite.T(), DayActionInfo("6000,1h", 75.0, 175))
+	assert.Contains(suite.T(), buf.String(), "height 175 is out of range")
+
+	_, err = DayActionStats("6000,1h", 75.0, 0)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrNonPositiveHeight)
+
+	var acc DayAccumulator
+	assert.NoError(suite.T(), acc.AddSteps("6000,1h"))
+	assert.Empty(suite.T(), acc.Info(75.0, 175))
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoErrPaths() {
+	tests := []struct {
+		name    string
+		input   string
+		weight  float64
+		wantErr error
+	}{
+		{name: "некорректная продолжительность", input: "6000,1 h", weight: 75.0, wantErr: spentcalories.ErrBadDataFormat},
+		{name: "ноль шагов", input: "0,1h00m", weight: 75.0, wantErr: spentcalories.ErrNonPositiveSteps},
+		{name: "отрицательный вес", input: "6000,1h00m", weight: -75.0, wantErr: spentcalories.ErrNonPositiveWeight},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DayActionInfoErr(tt.input, tt.weight, 1.75)
+
+			assert.Empty(suite.T(), got)
+			assert.True(suite.T(), errors.Is(err, tt.wantErr), "ожидалась ошибка %v, получено %v", tt.wantErr, err)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoFor() {
+	want := DayActionInfo("678,0h50m", 75.0, 1.75)
+
+	got, err := DayActionInfoFor("678,0h50m", spentcalories.UserProfile{Weight: 75.0, Height: 1.75})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	male, err := DayActionInfoFor("678,0h50m", spentcalories.UserProfile{Weight: 75.0, Height: 1.75, Age: 30, Sex: spentcalories.Male})
+	assert.NoError(suite.T(), err)
+	female, err := DayActionInfoFor("678,0h50m", spentcalories.UserProfile{Weight: 75.0, Height: 1.75, Age: 30, Sex: spentcalories.Female})
+	assert.NoError(suite.T(), err)
+	assert.NotEqual(suite.T(), male, female)
+
+	_, err = DayActionInfoFor("678,0h50m", spentcalories.UserProfile{Weight: 75.0, Height: 175})
+	var heightErr *spentcalories.HeightError
+	assert.ErrorAs(suite.T(), err, &heightErr)
+
+	_, err = DayActionInfoFor("678", spentcalories.UserProfile{Weight: 75.0, Height: 1.75})
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrBadDataFormat)
+}
+
+func (suite *DayStepsTestSuite) TestParsePackageClockDuration() {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr error
+	}{
+		{input: "678,1:30:00", want: 90 * time.Minute},
+		{input: "678,05:30", want: 5*time.Minute + 30*time.Second},
+		{input: "678,1h30m", want: 90 * time.Minute},
+		{input: "678,90", wantErr: spentcalories.ErrBadDataFormat},
+		{input: "678,1:75:00", wantErr: spentcalories.ErrBadDataFormat},
+		{input: "678,1h:30", wantErr: spentcalories.ErrBadDataFormat},
+		{input: "678,0:00:00", wantErr: spentcalories.ErrNonPositiveDuration},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.input, func() {
+			_, d, err := parsePackage(tt.input)
+			if tt.wantErr != nil {
+				assert.ErrorIs(suite.T(), err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, d)
+		})
+	}
+}
+
+// capturingLogger запоминает все сообщения, переданные в Printf.
+type capturingLogger struct {
+	messages []string
+}
+
+func (l *capturingLogger) Printf(format string, v ...any) {
+	l.messages = append(l.messages, fmt.Sprintf(format, v...))
+}
+
+func (suite *DayStepsTestSuite) TestSetLogger() {
+	var l capturingLogger
+	SetLogger(&l)
+	defer SetLogger(nil)
+
+	assert.Empty(suite.T(), DayActionInfo("678", 75.0, 1.75))
+	assert.Empty(suite.T(), DayActionInfo("abc,1h", 75.0, 1.75))
+	assert.NotEmpty(suite.T(), DayActionInfo("678,0h50m", 75.0, 1.75))
+
+	assert.Equal(suite.T(), []string{
+		"parsePackage: bad data format",
+		`parsePackage: failed to extract steps: strconv.Atoi: parsing "abc": invalid syntax`,
+	}, l.messages)
+}
+
+func (suite *DayStepsTestSuite) TestSetLoggerConcurrent() {
+	defer SetLogger(nil)
+
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			SetLogger(log.New(io.Discard, "", 0))
+		}()
+
+		go func() {
+			defer wg.Done()
+			DayActionInfo("678", 75.0, 1.75)
+		}()
+	}
+
+	wg.Wait()
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoFormat() {
+	got, err := DayActionInfoFormat("678,0h50m", 75.0, 1.75, spentcalories.DefaultFormat)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), DayActionInfo("678,0h50m", 75.0, 1.75), got)
+
+	got, err = DayActionInfoFormat("678,0h50m", 75.0, 1.75, spentcalories.FormatOptions{Decimals: 3, DecimalSep: ","})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Количество шагов: 678.\nДистанция составила 0,534 км.\nВы сожгли 20,022 ккал.\n", got)
+
+	_, err = DayActionInfoFormat("678,0h50m", 75.0, 1.75, spentcalories.FormatOptions{Decimals: -2})
+	assert.Error(suite.T(), err)
+
+	_, err = DayActionInfoFormat("678", 75.0, 1.75, spentcalories.DefaultFormat)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrBadDataFormat)
+}
+
+func (suite *DayStepsTestSuite) TestParsePackageTolerant() {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+	}{
+		{name: "пробелы после запятой", input: "678, 0h50m"},
+		{name: "пробелы вокруг полей", input: " 678 , 0h50m "},
+		{name: "точка с запятой", input: "678;0h50m"},
+		{name: "точка с запятой и пробелы", input: "678; 0h50m"},
+		{name: "BOM в начале", input: "\ufeff678,0h50m"},
+		{name: "BOM и точка с запятой", input: "\ufeff678;0h50m"},
+		{name: "лишнее поле", input: "678,0h50m,1", wantErr: true},
+		{name: "лишнее поле через точку с запятой", input: "678;0h50m;1", wantErr: true},
+		{name: "одно поле", input: "678", wantErr: true},
+		{name: "смешанные разделители", input: "678;0h50m,1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			steps, d, err := parsePackage(tt.input)
+			if tt.wantErr {
+				assert.ErrorIs(suite.T(), err, spentcalories.ErrBadDataFormat)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), 678, steps)
+			assert.Equal(suite.T(), 50*time.Minute, d)
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestDayActionStats() {
+	got, err := DayActionStats("100,1m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 100, got.Steps)
+	assert.Equal(suite.T(), time.Minute, got.Duration)
+	assert.InDelta(suite.T(), 78.75, got.DistanceMeters, 1e-9)
+	assert.InDelta(suite.T(), 0.07875, got.DistanceKm, 1e-12)
+
+	// строковый отчет не изменился.
+	info, err := DayActionInfoErr("100,1m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), info, got.String())
+	assert.Contains(suite.T(), info, "Дистанция составила 0.08 км.\n")
+
+	got, err = DayActionStats("6000,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 4725, got.DistanceMeters, 1e-9)
+	assert.InDelta(suite.T(), got.DistanceKm*1000, got.DistanceMeters, 1e-9)
+
+	_, err = DayActionStats("6000", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrBadDataFormat)
+}
+
+func (suite *DayStepsTestSuite) TestDayActionInfoOpts() {
+	const data = "3000,30m"
+
+	tests := []struct {
+		name string
+		opts []spentcalories.Option
+		want string
+	}{
+		{name: "по умолчанию", want: DayActionInfo(data, 75.0, 1.75)},
+		{
+			name: "один знак",
+			opts: []spentcalories.Option{spentcalories.WithPrecision(1)},
+			want: "Количество шагов: 3000.\nДистанция составила 2.4 км.\nВы сожгли 88.6 ккал.\n",
+		},
+		{
+			name: "без дистанции",
+			opts: []spentcalories.Option{spentcalories.WithoutDistance()},
+			want: "Количество шагов: 3000.\nВы сожгли 88.59 ккал.\n",
+		},
+		{
+			name: "без скорости - отчет не меняется",
+			opts: []spentcalories.Option{spentcalories.WithoutSpeed()},
+			want: DayActionInfo(data, 75.0, 1.75),
+		},
+		{
+			name: "одной строкой",
+			opts: []spentcalories.Option{spentcalories.WithCompactFormat()},
+			want: "Шагов: 3000, 2.36 км., 88.59 ккал\n",
+		},
+		{
+			name: "комбинация",
+			opts: []spentcalories.Option{spentcalories.WithCompactFormat(), spentcalories.WithoutDistance(), spentcalories.WithPrecision(0)},
+			want: "Шагов: 3000, 89 ккал\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := DayActionInfoOpts(data, 75.0, 1.75, tt.opts...)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+
+	_, err := DayActionInfoOpts(data, 75.0, 1.75, spentcalories.WithPrecision(-1))
+	assert.Error(suite.T(), err)
+
+	_, err = DayActionInfoOpts("3000", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrBadDataFormat)
+}
+
+func (suite *DayStepsTestSuite) TestParsePackageExported() {
+	steps, d, err := ParsePackage("678,0h50m")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 678, steps)
+	assert.Equal(suite.T(), 50*time.Minute, d)
+
+	_, _, err = ParsePackage("678")
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrBadDataFormat)
+
+	_, _, err = ParsePackage("678,0h00m")
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrNonPositiveDuration)
+}
@@ -0,0 +1,74 @@
+package daysteps
+
+import (
+	"fmt"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+// GoalPercent возвращает, на сколько процентов выполнена цель goal шагов
+// за день, округляя вниз и не больше 999%. Если цель не больше нуля,
+// возвращается 0.
+func (s DayStats) GoalPercent(goal int) int {
+	if goal <= 0 {
+		return 0
+	}
+
+	return min(s.Steps*100/goal, spentcalories.MaxGoalPercent)
+}
+
+// DayActionInfoWithGoal — то же, что DayActionInfoErr, но в конце отчета
+// добавляется строка о выполнении цели goal шагов за день:
+//
+//	Цель на день: 10000 шагов, выполнено 67%.
+//
+// Процент можно получить и без разбора строки, см. DayStats.GoalPercent.
+// Цель должна быть положительной.
+func DayActionInfoWithGoal(data string, weight, height float64, goal int) (string, error) {
+	if goal <= 0 {
+		return "", fmt.Errorf("step goal is not positive: %d", goal)
+	}
+
+	stats, err := dayStats(data, weight, height, 0)
+	if err != nil {
+		return "", err
+	}
+
+	return stats.String() + fmt.Sprintf("Цель на день: %d шагов, выполнено %d%%.\n", goal, stats.GoalPercent(goal)), nil
+}
+
+// GoalProgress — прогресс к цели по шагам за день.
+type GoalProgress struct {
+	Steps           int     // количество шагов.
+	Goal            int     // цель по шагам.
+	PercentComplete float64 // процент выполнения цели, может быть больше 100.
+	Remaining       int     // сколько шагов осталось до цели, 0 — цель выполнена.
+}
+
+// StepGoalProgress принимает:
+// data string — строку формата "678,0h50m", как в DayActionInfo.
+// goalSteps int — цель по шагам за день.
+//
+// В отличие от DayStats.GoalPercent, процент не округляется и не ограничивается:
+// 25000 шагов при цели 10000 — это 250%.
+//
+// Возвращает:
+// GoalProgress — прогресс к цели.
+// error — ошибку, если строка некорректна или цель не больше нуля.
+func StepGoalProgress(data string, goalSteps int) (GoalProgress, error) {
+	if goalSteps <= 0 {
+		return GoalProgress{}, fmt.Errorf("step goal is not positive: %d", goalSteps)
+	}
+
+	steps, _, err := parsePackage(data)
+	if err != nil {
+		return GoalProgress{}, fmt.Errorf("parsePackage: %w", err)
+	}
+
+	return GoalProgress{
+		Steps:           steps,
+		Goal:            goalSteps,
+		PercentComplete: float64(steps) * 100 / float64(goalSteps),
+		Remaining:       max(goalSteps-steps, 0),
+	}, nil
+}
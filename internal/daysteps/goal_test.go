@@ -0,0 +1,85 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+func (suite *DayStepsTestSuite) TestDayActionInfoWithGoal() {
+	want, err := DayActionInfoErr("6700,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got, err := DayActionInfoWithGoal("6700,1h00m", 75.0, 1.75, 10000)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want+"Цель на день: 10000 шагов, выполнено 67%.\n", got)
+
+	got, err = DayActionInfoWithGoal("150000,20h", 75.0, 1.75, 10000)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "выполнено 999%.\n")
+
+	for _, goal := range []int{0, -10000} {
+		_, err = DayActionInfoWithGoal("6700,1h00m", 75.0, 1.75, goal)
+		assert.ErrorContains(suite.T(), err, "step goal is not positive")
+	}
+
+	_, err = DayActionInfoWithGoal("6700", 75.0, 1.75, 10000)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrBadDataFormat)
+}
+
+func (suite *DayStepsTestSuite) TestGoalPercent() {
+	tests := []struct {
+		name  string
+		steps int
+		goal  int
+		want  int
+	}{
+		{name: "две трети", steps: 6700, goal: 10000, want: 67},
+		{name: "почти цель - округление вниз", steps: 9999, goal: 10000, want: 99},
+		{name: "ровно цель", steps: 10000, goal: 10000, want: 100},
+		{name: "больше цели", steps: 25000, goal: 10000, want: 250},
+		{name: "ограничение 999%", steps: 150000, goal: 10000, want: 999},
+		{name: "нулевая цель", steps: 6700, goal: 0, want: 0},
+		{name: "отрицательная цель", steps: 6700, goal: -1, want: 0},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, DayStats{Steps: tt.steps}.GoalPercent(tt.goal))
+		})
+	}
+}
+
+func (suite *DayStepsTestSuite) TestStepGoalProgress() {
+	tests := []struct {
+		name string
+		data string
+		goal int
+		want GoalProgress
+	}{
+		{name: "две трети", data: "6700,1h00m", goal: 10000, want: GoalProgress{Steps: 6700, Goal: 10000, PercentComplete: 67, Remaining: 3300}},
+		{name: "ровно цель", data: "10000,1h30m", goal: 10000, want: GoalProgress{Steps: 10000, Goal: 10000, PercentComplete: 100}},
+		{name: "больше цели", data: "25000,3h00m", goal: 10000, want: GoalProgress{Steps: 25000, Goal: 10000, PercentComplete: 250}},
+		{name: "больше 999%", data: "150000,20h", goal: 10000, want: GoalProgress{Steps: 150000, Goal: 10000, PercentComplete: 1500}},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := StepGoalProgress(tt.data, tt.goal)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+
+	got, err := StepGoalProgress("1,1h00m", 3)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 33.333, got.PercentComplete, 0.001)
+
+	for _, goal := range []int{0, -10000} {
+		_, err = StepGoalProgress("6700,1h00m", goal)
+		assert.ErrorContains(suite.T(), err, "step goal is not positive")
+	}
+
+	_, err = StepGoalProgress("6700", 10000)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrBadDataFormat)
+}
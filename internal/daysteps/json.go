@@ -0,0 +1,57 @@
+package daysteps
+
+import (
+	"encoding/json"
+	"math"
+	"time"
+)
+
+// dayStatsJSON — представление DayStats в JSON.
+//
+// Продолжительность хранится в секундах, а не в наносекундах time.Duration.
+// Дистанция хранится только в километрах, метры вычисляются при разборе.
+type dayStatsJSON struct {
+	Steps      int     `json:"steps"`
+	Duration   float64 `json:"duration_seconds"`
+	DistanceKm float64 `json:"distance_km"`
+	Calories   float64 `json:"calories"`
+}
+
+// MarshalJSON реализует json.Marshaler.
+func (s DayStats) MarshalJSON() ([]byte, error) {
+	return json.Marshal(dayStatsJSON{
+		Steps:      s.Steps,
+		Duration:   s.Duration.Seconds(),
+		DistanceKm: s.DistanceKm,
+		Calories:   s.Calories,
+	})
+}
+
+// UnmarshalJSON реализует json.Unmarshaler.
+func (s *DayStats) UnmarshalJSON(data []byte) error {
+	var v dayStatsJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*s = DayStats{
+		Steps:          v.Steps,
+		Duration:       time.Duration(math.Round(v.Duration * float64(time.Second))),
+		DistanceMeters: v.DistanceKm * mInKm,
+		DistanceKm:     v.DistanceKm,
+		Calories:       v.Calories,
+	}
+
+	return nil
+}
+
+// DayActionInfoJSON — то же, что DayActionInfoErr, но возвращает данные
+// об активности в формате JSON.
+func DayActionInfoJSON(data string, weight, height float64) ([]byte, error) {
+	stats, err := dayStats(data, weight, height, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(stats)
+}
@@ -0,0 +1,34 @@
+package daysteps
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestDayActionInfoJSON() {
+	got, err := DayActionInfoJSON("3000,30m", 75.0, 1.75)
+
+	assert.NoError(suite.T(), err)
+	assert.JSONEq(suite.T(), `{
+		"steps": 3000,
+		"duration_seconds": 1800,
+		"distance_km": 2.3625,
+		"calories": 88.59375
+	}`, string(got))
+
+	_, err = DayActionInfoJSON("not valid", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *DayStepsTestSuite) TestDayStatsJSONRoundTrip() {
+	want := DayStats{Steps: 678, Duration: 50*time.Minute + 30*time.Second, DistanceMeters: 440, DistanceKm: 0.44, Calories: 12.5}
+
+	data, err := json.Marshal(want)
+	assert.NoError(suite.T(), err)
+
+	var got DayStats
+	assert.NoError(suite.T(), json.Unmarshal(data, &got))
+	assert.Equal(suite.T(), want, got)
+}
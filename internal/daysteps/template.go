@@ -0,0 +1,55 @@
+package daysteps
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// Встроенные шаблоны отчета об активности для DayActionInfoWithFormat.
+var (
+	// DayTemplateRU повторяет вывод DayActionInfo.
+	DayTemplateRU = template.Must(template.New("ru").Parse(
+		"Количество шагов: {{.Steps}}.\n" +
+			"Дистанция составила {{.Distance}} км.\n" +
+			"Вы сожгли {{.Calories}} ккал.\n",
+	))
+
+	// DayTemplateEN — тот же отчет на английском языке.
+	DayTemplateEN = template.Must(template.New("en").Parse(
+		"Steps: {{.Steps}}.\n" +
+			"Distance: {{.Distance}} km.\n" +
+			"Calories burned: {{.Calories}} kcal.\n",
+	))
+)
+
+// dayView — данные об активности для шаблона. Числа уже отформатированы
+// с двумя знаками после запятой, чтобы вывод не зависел от шаблона.
+type dayView struct {
+	Steps    int
+	Distance string // в километрах.
+	Calories string
+}
+
+// DayActionInfoWithFormat — то же, что DayActionInfoErr, но отчет выводится
+// по шаблону tmpl, например DayTemplateEN. В шаблоне доступны поля Steps,
+// Distance и Calories; числа отформатированы с двумя знаками после запятой.
+func DayActionInfoWithFormat(data string, weight, height float64, tmpl *template.Template) (string, error) {
+	stats, err := dayStats(data, weight, height, 0)
+	if err != nil {
+		return "", err
+	}
+
+	view := dayView{
+		Steps:    stats.Steps,
+		Distance: fmt.Sprintf("%.2f", stats.DistanceKm),
+		Calories: fmt.Sprintf("%.2f", stats.Calories),
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, view); err != nil {
+		return "", fmt.Errorf("execute template: %w", err)
+	}
+
+	return sb.String(), nil
+}
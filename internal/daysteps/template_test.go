@@ -0,0 +1,20 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *DayStepsTestSuite) TestDayActionInfoWithFormat() {
+	want := DayActionInfo("678,0h50m", 75.0, 1.75)
+
+	got, err := DayActionInfoWithFormat("678,0h50m", 75.0, 1.75, DayTemplateRU)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	got, err = DayActionInfoWithFormat("678,0h50m", 75.0, 1.75, DayTemplateEN)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Steps: 678.\nDistance: 0.53 km.\nCalories burned: 20.02 kcal.\n", got)
+
+	_, err = DayActionInfoWithFormat("678", 75.0, 1.75, DayTemplateEN)
+	assert.Error(suite.T(), err)
+}
@@ -0,0 +1,70 @@
+package daysteps
+
+import (
+	"fmt"
+	"strings"
+)
+
+// daysInWeek — сколько последних дней учитывает WeekAccumulator.
+const daysInWeek = 7
+
+// WeekAccumulator собирает данные об активности за последние семь дней.
+// Каждый вызов Add добавляет один день; когда дней становится больше семи,
+// самый старый из них отбрасывается. Нулевое значение готово к работе.
+type WeekAccumulator struct {
+	days []DayStats
+}
+
+// Add разбирает строку data так же, как DayActionInfo, и добавляет ее
+// как очередной день. При ошибке накопленные данные не меняются.
+func (w *WeekAccumulator) Add(data string, weight, height float64) error {
+	stats, err := dayStats(data, weight, height, 0)
+	if err != nil {
+		return err
+	}
+
+	w.days = append(w.days, stats)
+	if len(w.days) > daysInWeek {
+		w.days = w.days[len(w.days)-daysInWeek:]
+	}
+
+	return nil
+}
+
+// Report возвращает сводку за неделю в формате:
+//
+//	Дней с записями: 2 из 7.
+//	Всего шагов: 9000.
+//	Общая дистанция: 7.09 км.
+//	Вы сожгли 265.78 ккал.
+//	В среднем шагов в день: 4500.
+//	Лучший день: 1-й, 6000 шагов.
+func (w *WeekAccumulator) Report() string {
+	var (
+		steps    int
+		distance float64
+		calories float64
+		best     int
+	)
+
+	for i, day := range w.days {
+		steps += day.Steps
+		distance += day.DistanceKm
+		calories += day.Calories
+
+		if day.Steps > w.days[best].Steps {
+			best = i
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Дней с записями: %d из %d.\n", len(w.days), daysInWeek)
+	fmt.Fprintf(&sb, "Всего шагов: %d.\nОбщая дистанция: %.2f км.\nВы сожгли %.2f ккал.\n", steps, distance, calories)
+
+	if len(w.days) > 0 {
+		fmt.Fprintf(&sb, "В среднем шагов в день: %d.\n", steps/len(w.days))
+		fmt.Fprintf(&sb, "Лучший день: %d-й, %d шагов.\n", best+1, w.days[best].Steps)
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,36 @@
+package daysteps
+
+import (
+	"github.com/stretchr/testify/assert"
+
+	"github.com/Yandex-Practicum/tracker/internal/spentcalories"
+)
+
+func (suite *DayStepsTestSuite) TestWeekAccumulator() {
+	var w WeekAccumulator
+
+	assert.Equal(suite.T(), "Дней с записями: 0 из 7.\nВсего шагов: 0.\nОбщая дистанция: 0.00 км.\nВы сожгли 0.00 ккал.\n", w.Report())
+
+	assert.NoError(suite.T(), w.Add("6000,1h00m", 75.0, 1.75))
+	assert.NoError(suite.T(), w.Add("3000,30m", 75.0, 1.75))
+
+	err := w.Add("abc,1h", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, spentcalories.ErrBadDataFormat)
+
+	want := "Дней с записями: 2 из 7.\nВсего шагов: 9000.\nОбщая дистанция: 7.09 км.\nВы сожгли 265.78 ккал.\nВ среднем шагов в день: 4500.\nЛучший день: 1-й, 6000 шагов.\n"
+	assert.Equal(suite.T(), want, w.Report())
+}
+
+func (suite *DayStepsTestSuite) TestWeekAccumulatorRolling() {
+	var w WeekAccumulator
+
+	for _, data := range []string{"9000,1h", "1000,1h", "2000,1h", "3000,1h", "4000,1h", "5000,1h", "6000,1h", "7000,1h"} {
+		assert.NoError(suite.T(), w.Add(data, 75.0, 1.75))
+	}
+
+	report := w.Report()
+	assert.Contains(suite.T(), report, "Дней с записями: 7 из 7.\n")
+	assert.Contains(suite.T(), report, "Всего шагов: 28000.\n")
+	assert.Contains(suite.T(), report, "В среднем шагов в день: 4000.\n")
+	assert.Contains(suite.T(), report, "Лучший день: 7-й, 7000 шагов.\n")
+}
@@ -0,0 +1,141 @@
+package spentcalories
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// activities — поддерживаемые виды активности в каноническом написании.
+var activities = []string{"Бег", "Ходьба", "Велоспорт", "Велосипед", "Плавание"}
+
+var (
+	aliasesMu sync.RWMutex
+
+	// activityAliases — другие названия видов активности в нижнем регистре
+	// и канонические написания, к которым они приводятся.
+	activityAliases = map[string]string{
+		"running":  "Бег",
+		"walking":  "Ходьба",
+		"cycling":  "Велоспорт",
+		"swimming": "Плавание",
+	}
+)
+
+// RegisterActivityAlias добавляет другое название alias для вида активности
+// activity, например "Laufen" для "Бег". Регистр alias не учитывается.
+// Если activity не входит в поддерживаемые виды активности,
+// возвращается ErrUnknownActivity. Название, добавленное через RegisterActivity,
+// псевдонимом сделать нельзя, как и наоборот.
+func RegisterActivityAlias(alias, activity string) error {
+	if !slices.Contains(activities, activity) {
+		return fmt.Errorf("%w: %q", ErrUnknownActivity, activity)
+	}
+
+	alias = strings.ToLower(strings.TrimSpace(alias))
+
+	// блокировки берутся в том же порядке, что и в RegisterActivity.
+	customMu.RLock()
+	defer customMu.RUnlock()
+
+	if _, ok := customActivities[alias]; ok {
+		return fmt.Errorf("activity %q is registered as custom", alias)
+	}
+
+	aliasesMu.Lock()
+	defer aliasesMu.Unlock()
+
+	activityAliases[alias] = activity
+
+	return nil
+}
+
+// CaloriesFunc считает калории для вида активности, добавленного
+// через RegisterActivity, по количеству шагов, весу (кг.), росту (м.)
+// и продолжительности тренировки.
+type CaloriesFunc func(steps int, weight, height float64, duration time.Duration) (float64, error)
+
+var (
+	customMu sync.RWMutex
+
+	// customActivities — виды активности, добавленные через RegisterActivity,
+	// по названию в нижнем регистре.
+	customActivities = map[string]CaloriesFunc{}
+)
+
+// RegisterActivity добавляет вид активности name, например "Лыжи",
+// калории для которого TrainingInfo считает функцией fn. Дистанция
+// и скорость считаются по шагам и росту, как для ходьбы.
+// Регистр name не учитывается.
+//
+// Встроенные виды активности и их псевдонимы переопределить нельзя:
+// для них возвращается ошибка. Повторная регистрация добавленного ранее
+// вида активности заменяет его функцию. Функцию можно вызывать
+// одновременно с разбором тренировок из других горутин.
+func RegisterActivity(name string, fn CaloriesFunc) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("activity name is empty")
+	}
+
+	if fn == nil {
+		return fmt.Errorf("calories func for %q is nil", name)
+	}
+
+	customMu.Lock()
+	defer customMu.Unlock()
+
+	if canonicalActivity(name) != name || slices.Contains(activities, name) {
+		return fmt.Errorf("activity %q is built in", name)
+	}
+
+	customActivities[strings.ToLower(name)] = fn
+
+	return nil
+}
+
+// customCalories возвращает функцию расчета калорий для вида активности
+// name, добавленного через RegisterActivity.
+func customCalories(name string) (CaloriesFunc, bool) {
+	customMu.RLock()
+	defer customMu.RUnlock()
+
+	fn, ok := customActivities[strings.ToLower(name)]
+
+	return fn, ok
+}
+
+// canonicalActivity убирает пробелы вокруг названия активности и без учета
+// регистра приводит его или его псевдоним к каноническому написанию,
+// например " бег " и "Running" — к "Бег".
+// Неизвестное название возвращается только без пробелов по краям.
+func canonicalActivity(name string) string {
+	name = activityName(name)
+
+	aliasesMu.RLock()
+	defer aliasesMu.RUnlock()
+
+	if activity, ok := activityAliases[strings.ToLower(name)]; ok {
+		return activity
+	}
+
+	return name
+}
+
+// activityName возвращает название активности для вывода: поддерживаемый
+// вид активности — в каноническом написании, остальные названия, в том числе
+// псевдонимы, — как их передал пользователь, без пробелов по краям.
+func activityName(name string) string {
+	name = strings.TrimSpace(name)
+
+	for _, activity := range activities {
+		if strings.EqualFold(name, activity) {
+			return activity
+		}
+	}
+
+	return name
+}
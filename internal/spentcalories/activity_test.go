@@ -0,0 +1,152 @@
+package spentcalories
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestCanonicalActivity() {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "Бег", want: "Бег"},
+		{input: "  бег ", want: "Бег"},
+		{input: "ХОДЬБА", want: "Ходьба"},
+		{input: "\tплавание", want: "Плавание"},
+		{input: " Йога ", want: "Йога"},
+		{input: "Running", want: "Бег"},
+		{input: " walking ", want: "Ходьба"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.input, func() {
+			assert.Equal(suite.T(), tt.want, canonicalActivity(tt.input))
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoActivityCase() {
+	got, err := TrainingInfo("6000,  бег ,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 354.38\n", got)
+
+	got, err = TrainingInfo("6000,ХОДЬБА,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Тип тренировки: Ходьба\n")
+
+	got, err = TrainingInfo("6000,Бегемот,1h00m", 75.0, 1.75)
+	assert.True(suite.T(), errors.Is(err, ErrUnknownActivity))
+	assert.Empty(suite.T(), got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoActivityAlias() {
+	got, err := TrainingInfo("6000,Running,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Running\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 354.38\n", got)
+
+	got, err = TrainingInfo("6000,WALKING,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Тип тренировки: WALKING\n")
+	assert.Contains(suite.T(), got, "Сожгли калорий: 177.19\n")
+
+	_, err = TrainingInfo("6000,Laufen,1h00m", 75.0, 1.75)
+	assert.True(suite.T(), errors.Is(err, ErrUnknownActivity))
+
+	assert.NoError(suite.T(), RegisterActivityAlias("Laufen", "Бег"))
+	got, err = TrainingInfo("6000,laufen,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Тип тренировки: laufen\n")
+
+	err = RegisterActivityAlias("Yoga", "Йога")
+	assert.True(suite.T(), errors.Is(err, ErrUnknownActivity))
+}
+
+func (suite *SpentCaloriesTestSuite) TestRegisterActivity() {
+	skiing := func(_ int, weight, _ float64, duration time.Duration) (float64, error) {
+		return CaloriesByMET(7.0, weight, duration)
+	}
+
+	_, err := TrainingInfo("6000,Лыжи,1h00m", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+
+	assert.NoError(suite.T(), RegisterActivity("Лыжи", skiing))
+	suite.T().Cleanup(func() { unregisterActivity("Лыжи") })
+
+	got, err := TrainingInfo("6000,лыжи,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: лыжи\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 525.00\n", got)
+
+	_, err = TrainingInfo("6000,Лыжи,1h00m,150", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrBadDataFormat)
+
+	// ошибка функции расчета возвращается вместе с названием активности.
+	_, err = TrainingInfo("6000,Лыжи,1h00m", -75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveWeight)
+	assert.ErrorContains(suite.T(), err, "Лыжи: ")
+
+	// повторная регистрация заменяет функцию.
+	assert.NoError(suite.T(), RegisterActivity("ЛЫЖИ", func(int, float64, float64, time.Duration) (float64, error) {
+		return 100, nil
+	}))
+	got, err = TrainingInfo("6000,Лыжи,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 100.00\n")
+	assert.NoError(suite.T(), RegisterActivity("Лыжи", skiing))
+
+	for _, name := range []string{"Бег", "ходьба", "Running", " swimming "} {
+		assert.ErrorContains(suite.T(), RegisterActivity(name, skiing), "is built in", name)
+	}
+
+	// добавленное название нельзя сделать псевдонимом встроенного вида активности.
+	assert.EqualError(suite.T(), RegisterActivityAlias(" лыжи ", "Бег"), `activity "лыжи" is registered as custom`)
+	got, err = TrainingInfo("6000,Лыжи,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 525.00\n")
+
+	assert.EqualError(suite.T(), RegisterActivity(" ", skiing), "activity name is empty")
+	assert.EqualError(suite.T(), RegisterActivity("Гребля", nil), `calories func for "Гребля" is nil`)
+}
+
+func (suite *SpentCaloriesTestSuite) TestRegisterActivityConcurrent() {
+	nordic := func(steps int, weight, height float64, duration time.Duration) (float64, error) {
+		calories, err := WalkingCalories(steps, Kilograms(weight), Metres(height), duration)
+		return float64(calories) * 1.2, err
+	}
+
+	suite.T().Cleanup(func() { unregisterActivity("Скандинавская ходьба") })
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+
+		go func() {
+			defer wg.Done()
+			assert.NoError(suite.T(), RegisterActivity("Скандинавская ходьба", nordic))
+		}()
+
+		go func() {
+			defer wg.Done()
+			_, err := TrainingInfo("6000,Бег,1h00m", 75.0, 1.75)
+			assert.NoError(suite.T(), err)
+		}()
+	}
+	wg.Wait()
+
+	got, err := TrainingInfo("6000,Скандинавская ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 212.62\n")
+}
+
+// unregisterActivity удаляет вид активности, добавленный в тесте,
+// чтобы тесты можно было запускать повторно.
+func unregisterActivity(name string) {
+	customMu.Lock()
+	defer customMu.Unlock()
+
+	delete(customActivities, strings.ToLower(name))
+}
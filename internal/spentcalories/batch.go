@@ -0,0 +1,101 @@
+package spentcalories
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TrainingInfoBatch принимает:
+// data string — записи о тренировках, по одной на строку; пустые строки пропускаются.
+// weight, height float64 — вес (кг.) и рост (м.) пользователя.
+//
+// Ошибка в одной строке не прерывает обработку остальных.
+//
+// Возвращает:
+// []TrainingResult — результаты по непустым строкам.
+// []error — ошибки той же длины: nil для успешной строки или ошибка с номером строки.
+func TrainingInfoBatch(data string, weight, height float64) ([]TrainingResult, []error) {
+	var (
+		results []TrainingResult
+		errs    []error
+	)
+
+	for i, line := range strings.Split(data, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		t, err := ParseTrainingData(line, weight, height)
+		if err != nil {
+			err = fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		results = append(results, t)
+		errs = append(errs, err)
+	}
+
+	return results, errs
+}
+
+// ReadTrainings принимает:
+// r io.Reader — источник записей о тренировках, по одной на строку.
+// weight, height float64 — вес (кг.) и рост (м.) пользователя.
+//
+// Строки читаются через Scanner, поэтому пустые строки и строки,
+// начинающиеся с '#', пропускаются. Ошибка в одной строке не прерывает
+// обработку остальных, а ошибка чтения из r, в том числе bufio.ErrTooLong
+// для строки длиннее 64 КиБ, прекращает обработку и добавляется к ним.
+//
+// Возвращает:
+// []Training — тренировки из успешно разобранных строк.
+// error — объединенные через errors.Join ошибки с номерами строк или nil.
+func ReadTrainings(r io.Reader, weight, height float64) ([]Training, error) {
+	var (
+		trainings []Training
+		errs      []error
+	)
+
+	s := NewScanner(r, weight, height)
+	s.onLineErr = func(err error) {
+		errs = append(errs, err)
+	}
+
+	for s.Scan() {
+		trainings = append(trainings, s.Training())
+	}
+
+	if err := s.Err(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return trainings, errors.Join(errs...)
+}
+
+// ScanLines построчно читает r и вызывает fn для каждой значимой строки
+// с ее номером, начиная с единицы. Строки отбираются по тем же правилам,
+// что и в Scanner: пустые строки и строки, начинающиеся с '#', пропускаются,
+// а строка длиннее 64 КиБ прекращает чтение с ошибкой bufio.ErrTooLong.
+// Возвращает ошибку чтения из r.
+func ScanLines(r io.Reader, fn func(n int, line string)) error {
+	return ScanLinesErr(r, func(n int, line string) error {
+		fn(n, line)
+		return nil
+	})
+}
+
+// ScanLinesErr — то же, что ScanLines, но чтение прекращается, как только
+// fn вернет ошибку, и эта ошибка возвращается без изменений.
+func ScanLinesErr(r io.Reader, fn func(n int, line string) error) error {
+	s := NewScanner(r, 0, 0)
+
+	for s.nextLine() {
+		if err := fn(s.line, s.text); err != nil {
+			return err
+		}
+	}
+
+	return s.Err()
+}
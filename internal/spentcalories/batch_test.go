@@ -0,0 +1,98 @@
+package spentcalories
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoBatch() {
+	data := "6000,Бег,1h00m\n\nsomething is wrong\r\n3000,Ходьба,30m\n6000,Йога,1h00m\n"
+
+	results, errs := TrainingInfoBatch(data, 75.0, 1.75)
+
+	assert.Len(suite.T(), results, 4)
+	assert.Len(suite.T(), errs, 4)
+
+	assert.NoError(suite.T(), errs[0])
+	assert.Equal(suite.T(), "Бег", results[0].Activity)
+
+	assert.ErrorContains(suite.T(), errs[1], "line 3:")
+	assert.True(suite.T(), errors.Is(errs[1], ErrBadDataFormat))
+	assert.Equal(suite.T(), TrainingResult{}, results[1])
+
+	assert.NoError(suite.T(), errs[2])
+	assert.Equal(suite.T(), "Ходьба", results[2].Activity)
+	assert.InDelta(suite.T(), 88.59375, results[2].Calories, 1e-9)
+
+	assert.ErrorContains(suite.T(), errs[3], "line 5:")
+	assert.True(suite.T(), errors.Is(errs[3], ErrUnknownActivity))
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoBatchEmpty() {
+	results, errs := TrainingInfoBatch("\n  \n", 75.0, 1.75)
+
+	assert.Empty(suite.T(), results)
+	assert.Empty(suite.T(), errs)
+}
+
+func (suite *SpentCaloriesTestSuite) TestReadTrainings() {
+	data := "# дневник тренировок\n6000,Бег,1h00m\n\nsomething is wrong\r\n3000,Ходьба,30m\n6000,Йога,1h00m\n"
+
+	trainings, err := ReadTrainings(strings.NewReader(data), 75.0, 1.75)
+
+	assert.Len(suite.T(), trainings, 2)
+	assert.Equal(suite.T(), "Бег", trainings[0].Activity)
+	assert.Equal(suite.T(), "Ходьба", trainings[1].Activity)
+
+	assert.ErrorContains(suite.T(), err, "line 4:")
+	assert.ErrorContains(suite.T(), err, "line 6:")
+	assert.True(suite.T(), errors.Is(err, ErrBadDataFormat))
+	assert.True(suite.T(), errors.Is(err, ErrUnknownActivity))
+
+	trainings, err = ReadTrainings(strings.NewReader("# пусто\n\n"), 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Empty(suite.T(), trainings)
+}
+
+func (suite *SpentCaloriesTestSuite) TestReadTrainingsReadError() {
+	readErr := errors.New("disk is gone")
+	r := io.MultiReader(strings.NewReader("6000,Бег,1h00m\n"), iotest.ErrReader(readErr))
+
+	trainings, err := ReadTrainings(r, 75.0, 1.75)
+	assert.Len(suite.T(), trainings, 1)
+	assert.True(suite.T(), errors.Is(err, readErr))
+}
+
+func (suite *SpentCaloriesTestSuite) TestReadTrainingsLongLine() {
+	data := "6000,Бег,1h00m\n" + strings.Repeat("#", 70*1024) + "\n3000,Ходьба,30m\n"
+
+	trainings, err := ReadTrainings(strings.NewReader(data), 75.0, 1.75)
+	assert.Len(suite.T(), trainings, 1)
+	assert.ErrorIs(suite.T(), err, bufio.ErrTooLong)
+
+	err = ScanLines(strings.NewReader(data), func(int, string) {})
+	assert.ErrorIs(suite.T(), err, bufio.ErrTooLong)
+}
+
+func (suite *SpentCaloriesTestSuite) TestScanLinesErr() {
+	data := "# заголовок\n6000,Бег,1h00m\n\n3000,Ходьба,30m\n6000,Йога,1h00m\n"
+
+	var lines []int
+	errStop := errors.New("stop")
+
+	err := ScanLinesErr(strings.NewReader(data), func(n int, line string) error {
+		lines = append(lines, n)
+		if n == 4 {
+			return errStop
+		}
+
+		return nil
+	})
+	assert.ErrorIs(suite.T(), err, errStop)
+	assert.Equal(suite.T(), []int{2, 4}, lines)
+}
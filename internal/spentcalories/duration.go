@@ -0,0 +1,55 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseDuration разбирает продолжительность в формате Go ("1h30m")
+// или в формате часов "Ч:ММ:СС" и "ММ:СС" ("1:30:00", "45:00").
+// В формате часов каждая часть состоит только из цифр, без знака,
+// а минуты и секунды после первой части должны быть меньше 60.
+func ParseDuration(s string) (time.Duration, error) {
+	if !strings.Contains(s, ":") {
+		return time.ParseDuration(s)
+	}
+
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("invalid clock duration %q", s)
+	}
+
+	var d time.Duration
+	for i, part := range parts {
+		if !isDigits(part) {
+			return 0, fmt.Errorf("invalid clock duration %q", s)
+		}
+
+		n, err := strconv.Atoi(part)
+		if err != nil || (i > 0 && n >= 60) {
+			return 0, fmt.Errorf("invalid clock duration %q", s)
+		}
+
+		d = d*60 + time.Duration(n)
+	}
+
+	return d * time.Second, nil
+}
+
+// isDigits сообщает, что s непуста и состоит только из цифр 0-9,
+// без знака и пробелов.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
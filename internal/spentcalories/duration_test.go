@@ -0,0 +1,65 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestParseDuration() {
+	tests := []struct {
+		input   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{input: "1h30m", want: 90 * time.Minute},
+		{input: "1:30:00", want: 90 * time.Minute},
+		{input: "45:00", want: 45 * time.Minute},
+		{input: "0:00:30", want: 30 * time.Second},
+		{input: "abc", wantErr: true},
+		{input: "1:60:00", wantErr: true},
+		{input: "1:-5:00", wantErr: true},
+		{input: "1:2:3:4", wantErr: true},
+		{input: "1::00", wantErr: true},
+		{input: "90", wantErr: true},
+		{input: "1h:30", wantErr: true},
+		{input: ":30", wantErr: true},
+		{input: "0:00:00", want: 0},
+		{input: "-0:30", wantErr: true},
+		{input: "1:-0:30", wantErr: true},
+		{input: "+1:30", wantErr: true},
+		{input: " 1:30", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.input, func() {
+			got, err := ParseDuration(tt.input)
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingClockDuration() {
+	_, _, d, err := ParseTraining("3456,Ходьба,3:00:00")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3*time.Hour, d)
+
+	_, _, _, err = ParseTraining("3456,Ходьба,abc")
+	assert.ErrorIs(suite.T(), err, ErrBadDataFormat)
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingZeroClockDuration() {
+	_, _, _, err := ParseTraining("3456,Ходьба,0:00:00")
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDuration)
+}
+
+func (suite *SpentCaloriesTestSuite) TestNegativeZeroClockDuration() {
+	_, err := TrainingInfo("3456,Ходьба,-0:30", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrBadDataFormat)
+}
@@ -0,0 +1,45 @@
+package spentcalories
+
+import "fmt"
+
+// kJInKcal — количество килоджоулей в килокалории.
+const kJInKcal = 4.184
+
+// EnergyUnit — единица, в которой выводится затраченная энергия.
+type EnergyUnit int
+
+const (
+	Kilocalories EnergyUnit = iota // килокалории, как в TrainingInfo.
+	Kilojoules                     // килоджоули.
+)
+
+// KcalToKJ переводит энергию из килокалорий в килоджоули.
+func KcalToKJ(kcal float64) float64 {
+	return kcal * kJInKcal
+}
+
+// compact возвращает затраченную энергию с единицей измерения
+// для однострочного отчета: "177.19 ккал".
+func (unit EnergyUnit) compact(kcal float64, opts FormatOptions) string {
+	if unit == Kilojoules {
+		return opts.FormatFloat(KcalToKJ(kcal)) + " кДж"
+	}
+
+	return opts.FormatFloat(kcal) + " ккал"
+}
+
+// TrainingInfoEnergy — то же, что TrainingInfo, но затраченная энергия
+// выводится в единицах unit. Для Kilojoules последняя строка отчета
+// заменяется на "Затрачено энергии: 1255.20 кДж".
+func TrainingInfoEnergy(data string, weight, height float64, unit EnergyUnit) (string, error) {
+	if unit != Kilocalories && unit != Kilojoules {
+		return "", fmt.Errorf("unknown energy unit: %d", unit)
+	}
+
+	t, err := ParseTrainingData(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	return t.format(Metric, unit, defaultReport), nil
+}
@@ -0,0 +1,33 @@
+package spentcalories
+
+import (
+	"strings"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestKcalToKJ() {
+	assert.InDelta(suite.T(), 4.184, KcalToKJ(1), 1e-9)
+	assert.InDelta(suite.T(), 1255.2, KcalToKJ(300), 1e-9)
+	assert.Equal(suite.T(), 0.0, KcalToKJ(0))
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoEnergy() {
+	legacy, err := TrainingInfo("6000,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	kcal, err := TrainingInfoEnergy("6000,Ходьба,1h00m", 75.0, 1.75, Kilocalories)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), legacy, kcal)
+
+	kJ, err := TrainingInfoEnergy("6000,Ходьба,1h00m", 75.0, 1.75, Kilojoules)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), strings.Replace(legacy, "Сожгли калорий: 177.19\n", "Затрачено энергии: 741.35 кДж\n", 1), kJ)
+	assert.NotContains(suite.T(), kJ, "Сожгли калорий")
+
+	_, err = TrainingInfoEnergy("6000,Ходьба,1h00m", 75.0, 1.75, EnergyUnit(42))
+	assert.ErrorContains(suite.T(), err, "unknown energy unit")
+
+	_, err = TrainingInfoEnergy("6000,Ходьба", 75.0, 1.75, Kilojoules)
+	assert.ErrorIs(suite.T(), err, ErrBadDataFormat)
+}
@@ -0,0 +1,20 @@
+package spentcalories
+
+import "errors"
+
+// Ошибки, которые возвращают функции пакета.
+//
+// Они оборачиваются через %w, поэтому их можно проверять с помощью errors.Is.
+var (
+	ErrBadDataFormat       = errors.New("bad data format")
+	ErrNonPositiveSteps    = errors.New("steps is not positive")
+	ErrTooManySteps        = errors.New("steps exceed the maximum")
+	ErrNonPositiveWeight   = errors.New("weight is not positive")
+	ErrNonPositiveHeight   = errors.New("height is not positive")
+	ErrNonPositiveDuration = errors.New("duration is not positive")
+	ErrNonPositiveDistance = errors.New("distance is not positive")
+	ErrUnknownActivity     = errors.New("неизвестный тип тренировки")
+	ErrImplausible         = errors.New("implausible training")
+	ErrCaloriesOverflow    = errors.New("calories are not finite")
+	ErrGradeOutOfRange     = errors.New("grade is out of range")
+)
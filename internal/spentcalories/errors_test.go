@@ -0,0 +1,271 @@
+package spentcalories
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSentinelErrors() {
+	tests := []struct {
+		name    string
+		err     func() error
+		wantErr error
+	}{
+		{
+			name: "ParseTraining - неверный формат",
+			err: func() error {
+				_, _, _, err := ParseTraining("678,Ходьба")
+				return err
+			},
+			wantErr: ErrBadDataFormat,
+		},
+		{
+			name: "ParseTraining - шаги не число",
+			err: func() error {
+				_, _, _, err := ParseTraining("abc,Ходьба,1h")
+				return err
+			},
+			wantErr: ErrBadDataFormat,
+		},
+		{
+			name: "TrainingInfo - четвертое поле не положительное",
+			err: func() error {
+				_, err := TrainingInfo("40,Плавание,45m,0", 75.0, 1.75)
+				return err
+			},
+			wantErr: ErrBadDataFormat,
+		},
+		{
+			name: "ParseTraining - ноль шагов",
+			err: func() error {
+				_, _, _, err := ParseTraining("0,Ходьба,1h")
+				return err
+			},
+			wantErr: ErrNonPositiveSteps,
+		},
+		{
+			name: "ParseTraining - нулевая продолжительность",
+			err: func() error {
+				_, _, _, err := ParseTraining("678,Ходьба,0h")
+				return err
+			},
+			wantErr: ErrNonPositiveDuration,
+		},
+		{
+			name: "RunningSpentCalories - отрицательные шаги",
+			err: func() error {
+				_, err := RunningSpentCalories(-1, 75, 1.75, time.Hour)
+				return err
+			},
+			wantErr: ErrNonPositiveSteps,
+		},
+		{
+			name: "RunningSpentCalories - нулевой вес",
+			err: func() error {
+				_, err := RunningSpentCalories(1000, 0, 1.75, time.Hour)
+				return err
+			},
+			wantErr: ErrNonPositiveWeight,
+		},
+		{
+			name: "WalkingSpentCalories - нулевой рост",
+			err: func() error {
+				_, err := WalkingSpentCalories(1000, 75, 0, time.Hour)
+				return err
+			},
+			wantErr: ErrNonPositiveHeight,
+		},
+		{
+			name: "TrainingInfo - неизвестный тип тренировки",
+			err: func() error {
+				_, err := TrainingInfo("6000,Йога,1h00m", 75, 1.75)
+				return err
+			},
+			wantErr: ErrUnknownActivity,
+		},
+		{
+			name: "TrainingInfo - нулевой вес",
+			err: func() error {
+				_, err := TrainingInfo("6000,Бег,1h00m", 0, 1.75)
+				return err
+			},
+			wantErr: ErrNonPositiveWeight,
+		},
+		{
+			name: "TrainingInfo - неверный формат",
+			err: func() error {
+				_, err := TrainingInfo("6000,Бег", 75, 1.75)
+				return err
+			},
+			wantErr: ErrBadDataFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := tt.err()
+			assert.True(suite.T(), errors.Is(err, tt.wantErr), "ожидалась ошибка %v, получено %v", tt.wantErr, err)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTooManySteps() {
+	tests := []struct {
+		name string
+		err  func() error
+	}{
+		{
+			name: "ParseTraining",
+			err: func() error {
+				_, _, _, err := ParseTraining("200001,Ходьба,1h")
+				return err
+			},
+		},
+		{
+			name: "TrainingInfo - около math.MaxInt",
+			err: func() error {
+				_, err := TrainingInfo("9223372036854775807,Бег,1h", 75, 1.75)
+				return err
+			},
+		},
+		{
+			name: "RunningCalories",
+			err: func() error {
+				_, err := RunningCalories(math.MaxInt, 75, 1.75, time.Hour)
+				return err
+			},
+		},
+		{
+			name: "WalkingCalories",
+			err: func() error {
+				_, err := WalkingCalories(1000000, 75, 1.75, time.Hour)
+				return err
+			},
+		},
+		{
+			name: "WalkingSpentCaloriesMET",
+			err: func() error {
+				_, err := WalkingSpentCaloriesMET(1000000, 75, 1.75, time.Hour)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			err := tt.err()
+			assert.ErrorIs(suite.T(), err, ErrTooManySteps)
+		})
+	}
+
+	_, _, _, err := ParseTraining("200000,Ходьба,24h")
+	assert.NoError(suite.T(), err)
+
+	// для велосипеда первое поле — метры, а не шаги.
+	_, err = TrainingInfo("250000,Велоспорт,10h", 75, 1.75)
+	assert.NoError(suite.T(), err)
+
+	// Limits.MaxSteps ужесточает границу.
+	for _, input := range []string{"100001,Ходьба,24h", "9223372036854775807,Бег,1h"} {
+		_, err := TrainingInfoWithLimits(input, 75, 1.75, NewDefaultLimits())
+		assert.ErrorIs(suite.T(), err, ErrTooManySteps, input)
+	}
+
+	_, err = TrainingInfoWithLimits("100000,Ходьба,24h", 75, 1.75, NewDefaultLimits())
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesOverflow() {
+	_, err := TrainingInfo("6000,Бег,1h00m", math.MaxFloat64, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrCaloriesOverflow)
+
+	_, err = TrainingInfo("6000,Ходьба,1h00m,terrain=snow", math.MaxFloat64/2, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrCaloriesOverflow)
+
+	_, err = RunningCalories(6000, math.MaxFloat64, 1.75, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrCaloriesOverflow)
+
+	_, err = WalkingCalories(6000, math.MaxFloat64, 1.75, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrCaloriesOverflow)
+
+	_, err = CaloriesByMET(3.5, math.MaxFloat64, time.Duration(math.MaxInt64))
+	assert.ErrorIs(suite.T(), err, ErrCaloriesOverflow)
+}
+
+func (suite *SpentCaloriesTestSuite) TestUnknownActivityValue() {
+	_, err := TrainingInfo("6000, Йога ,1h00m", 75, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+	assert.EqualError(suite.T(), err, `неизвестный тип тренировки: "Йога"`)
+
+	_, err = ParseTrainingData("6000,бегемот,1h00m", 75, 1.75)
+	assert.EqualError(suite.T(), err, `неизвестный тип тренировки: "бегемот"`)
+
+	_, err = CaloriesFromSpeed(5, 75, time.Hour, "Йога")
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+	assert.EqualError(suite.T(), err, `неизвестный тип тренировки: "Йога"`)
+}
+
+func (suite *SpentCaloriesTestSuite) TestUnknownActivityLine() {
+	_, err := ReadTrainings(strings.NewReader("6000,Бег,1h00m\n6000,Пилатес,1h00m\n6000,Ходьба,1h00m\n"), 75, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+	assert.EqualError(suite.T(), err, `line 2: неизвестный тип тренировки: "Пилатес"`)
+
+	_, errs := TrainingInfoBatch("6000,Бег,1h00m\n6000,Йога,1h00m", 75, 1.75)
+	assert.NoError(suite.T(), errs[0])
+	assert.EqualError(suite.T(), errs[1], `line 2: неизвестный тип тренировки: "Йога"`)
+}
+
+func (suite *SpentCaloriesTestSuite) TestInvalidHeight() {
+	var heightErr *HeightError
+
+	for _, input := range []string{"6000,Бег,1h00m", "6000,Ходьба,1h00m", "5600,Бег,40m,156"} {
+		for _, height := range []float64{0, -1.75} {
+			got, err := TrainingInfo(input, 75, height)
+			assert.ErrorIs(suite.T(), err, ErrNonPositiveHeight, "%s, рост %v", input, height)
+			assert.Empty(suite.T(), got)
+		}
+
+		// рост в сантиметрах: TrainingInfo их не принимает и не предлагает.
+		got, err := TrainingInfo(input, 75, 175)
+		assert.ErrorAs(suite.T(), err, &heightErr, input)
+		assert.EqualError(suite.T(), err, "height 175 is out of range: expected 0.9-2.5 metres")
+		assert.Empty(suite.T(), got)
+	}
+
+	// велосипеду и плаванию рост не нужен.
+	for _, input := range []string{"3000,Велосипед,30m,10", "0,Велоспорт,1h,25", "40,Плавание,45m,50"} {
+		want, err := TrainingInfo(input, 75, 1.75)
+		assert.NoError(suite.T(), err, input)
+
+		for _, height := range []float64{0.8, 0, 175} {
+			got, err := TrainingInfo(input, 75, height)
+			assert.NoError(suite.T(), err, "%s, рост %v", input, height)
+			assert.Equal(suite.T(), want, got, "%s, рост %v", input, height)
+		}
+	}
+
+	// неизвестная активность остается ошибкой активности, а не роста.
+	_, err := TrainingInfo("6000,Йога,1h00m", 75, 0)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+
+	_, err = TrainingInfoFromJSON([]byte(`{"steps": 6000, "activity": "Бег", "duration": "1h"}`), 75, 175)
+	assert.ErrorAs(suite.T(), err, &heightErr)
+
+	for _, height := range []float64{0, -1.75} {
+		_, err = RunningSpentCalories(6000, 75, height, time.Hour)
+		assert.ErrorIs(suite.T(), err, ErrNonPositiveHeight)
+
+		_, err = WalkingSpentCalories(6000, 75, height, time.Hour)
+		assert.ErrorIs(suite.T(), err, ErrNonPositiveHeight)
+
+		_, err = Distance(6000, height)
+		assert.ErrorIs(suite.T(), err, ErrNonPositiveHeight)
+
+		assert.Zero(suite.T(), distance(6000, height))
+		assert.Zero(suite.T(), meanSpeed(6000, height, time.Hour))
+	}
+}
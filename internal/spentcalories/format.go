@@ -0,0 +1,51 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// FormatOptions задает вывод чисел в отчетах.
+type FormatOptions struct {
+	Decimals   int    // количество знаков после разделителя.
+	DecimalSep string // десятичный разделитель, пустая строка — точка.
+}
+
+// DefaultFormat — формат чисел, которым пользуются TrainingInfo и DayActionInfo:
+// два знака после точки.
+var DefaultFormat = FormatOptions{Decimals: 2, DecimalSep: "."}
+
+// Validate проверяет, что количество знаков после разделителя неотрицательно.
+func (o FormatOptions) Validate() error {
+	if o.Decimals < 0 {
+		return fmt.Errorf("decimals is negative: %d", o.Decimals)
+	}
+
+	return nil
+}
+
+// FormatFloat форматирует v с o.Decimals знаками после разделителя o.DecimalSep.
+func (o FormatOptions) FormatFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'f', o.Decimals, 64)
+	if o.DecimalSep == "" || o.DecimalSep == "." {
+		return s
+	}
+
+	return strings.Replace(s, ".", o.DecimalSep, 1)
+}
+
+// TrainingInfoFormat — то же, что TrainingInfo, но числа выводятся
+// в формате opts, например FormatOptions{Decimals: 1, DecimalSep: ","}.
+func TrainingInfoFormat(data string, weight, height float64, opts FormatOptions) (string, error) {
+	if err := opts.Validate(); err != nil {
+		return "", err
+	}
+
+	t, err := ParseTrainingData(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	return t.format(Metric, Kilocalories, ReportOptions{Format: opts}), nil
+}
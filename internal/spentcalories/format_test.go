@@ -0,0 +1,55 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestFormatFloat() {
+	tests := []struct {
+		name string
+		opts FormatOptions
+		want string
+	}{
+		{name: "по умолчанию", opts: DefaultFormat, want: "354.38"},
+		{name: "запятая", opts: FormatOptions{Decimals: 2, DecimalSep: ","}, want: "354,38"},
+		{name: "один знак", opts: FormatOptions{Decimals: 1, DecimalSep: ","}, want: "354,4"},
+		{name: "без знаков", opts: FormatOptions{Decimals: 0, DecimalSep: ","}, want: "354"},
+		{name: "пустой разделитель - точка", opts: FormatOptions{Decimals: 3}, want: "354.375"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, tt.opts.FormatFloat(354.375))
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoFormat() {
+	want, err := TrainingInfo("6000,Ходьба,1h00m,terrain=snow", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got, err := TrainingInfoFormat("6000,Ходьба,1h00m,terrain=snow", 75.0, 1.75, DefaultFormat)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	got, err = TrainingInfoFormat("6000,Бег,1h00m", 75.0, 1.75, FormatOptions{Decimals: 1, DecimalSep: ","})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Бег\nДлительность: 1,0 ч.\nДистанция: 4,7 км.\nСкорость: 4,7 км/ч\nТемп: 12:42 мин/км\nКаденс: 100,0 шаг/мин\nСожгли калорий: 354,4\n", got)
+
+	_, err = TrainingInfoFormat("6000,Бег,1h00m", 75.0, 1.75, FormatOptions{Decimals: -1})
+	assert.EqualError(suite.T(), err, "decimals is negative: -1")
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoFormatPrecision() {
+	got, err := TrainingInfoFormat("3000,Бег,30m", 75.0, 1.75, FormatOptions{Decimals: 0})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Бег\nДлительность: 0 ч.\nДистанция: 2 км.\nСкорость: 5 км/ч\nТемп: 12:42 мин/км\nКаденс: 100 шаг/мин\nСожгли калорий: 177\n", got)
+
+	got, err = TrainingInfoFormat("3000,Бег,30m", 75.0, 1.75, FormatOptions{Decimals: 3})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Бег\nДлительность: 0.500 ч.\nДистанция: 2.362 км.\nСкорость: 4.725 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.000 шаг/мин\nСожгли калорий: 177.188\n", got)
+
+	got, err = TrainingInfoFormat("3000,Бег,30m", 75.0, 1.75, FormatOptions{Decimals: 3, DecimalSep: ","})
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 177,188\n")
+}
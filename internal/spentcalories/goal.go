@@ -0,0 +1,60 @@
+package spentcalories
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// MaxGoalPercent — верхняя граница процента выполнения цели,
+// в том числе в отчетах пакета daysteps.
+const MaxGoalPercent = 999
+
+// Goal — цель на день. Нулевое поле означает, что такой цели нет.
+type Goal struct {
+	StepsTarget    int     // цель по шагам.
+	CaloriesTarget float64 // цель по калориям.
+}
+
+// goalPercent возвращает value в процентах от target, но не больше 999%.
+// Если цель не больше нуля, возвращается 0.
+func goalPercent(value, target float64) float64 {
+	if target <= 0 {
+		return 0
+	}
+
+	return math.Min(value/target*100, MaxGoalPercent)
+}
+
+// ProgressToward возвращает, на сколько процентов выполнены цели goal
+// по шагам и по калориям. Проценты не больше 999; для цели, равной нулю,
+// возвращается 0.
+func (s Summary) ProgressToward(goal Goal) (stepsPct, caloriesPct float64) {
+	return goalPercent(float64(s.TotalSteps), float64(goal.StepsTarget)),
+		goalPercent(s.TotalCalories, goal.CaloriesTarget)
+}
+
+// StringWithGoal — то же, что String, но в конце добавляется строка
+// о выполнении целей goal, проценты округляются до целых:
+//
+//	Выполнено: 87% шагов, 64% калорий.
+//
+// Цели, равные нулю, в строку не попадают; если целей нет, строка не добавляется.
+func (s Summary) StringWithGoal(goal Goal) string {
+	stepsPct, caloriesPct := s.ProgressToward(goal)
+
+	var parts []string
+	if goal.StepsTarget > 0 {
+		parts = append(parts, fmt.Sprintf("%.0f%% шагов", stepsPct))
+	}
+
+	if goal.CaloriesTarget > 0 {
+		parts = append(parts, fmt.Sprintf("%.0f%% калорий", caloriesPct))
+	}
+
+	if len(parts) == 0 {
+		return s.String()
+	}
+
+	return s.String() + "Выполнено: " + strings.Join(parts, ", ") + ".\n"
+}
@@ -0,0 +1,50 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestProgressToward() {
+	goal := Goal{StepsTarget: 10000, CaloriesTarget: 500}
+
+	tests := []struct {
+		name         string
+		summary      Summary
+		wantSteps    float64
+		wantCalories float64
+	}{
+		{name: "меньше цели", summary: Summary{TotalSteps: 8700, TotalCalories: 320}, wantSteps: 87, wantCalories: 64},
+		{name: "ровно цель", summary: Summary{TotalSteps: 10000, TotalCalories: 500}, wantSteps: 100, wantCalories: 100},
+		{name: "больше цели", summary: Summary{TotalSteps: 25000, TotalCalories: 750}, wantSteps: 250, wantCalories: 150},
+		{name: "ограничение 999%", summary: Summary{TotalSteps: 150000, TotalCalories: 9000}, wantSteps: 999, wantCalories: 999},
+		{name: "пустой день", summary: Summary{}, wantSteps: 0, wantCalories: 0},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			steps, calories := tt.summary.ProgressToward(goal)
+			assert.InDelta(suite.T(), tt.wantSteps, steps, 1e-9)
+			assert.InDelta(suite.T(), tt.wantCalories, calories, 1e-9)
+		})
+	}
+
+	steps, calories := Summary{TotalSteps: 8700, TotalCalories: 320}.ProgressToward(Goal{})
+	assert.Zero(suite.T(), steps)
+	assert.Zero(suite.T(), calories)
+}
+
+func (suite *SpentCaloriesTestSuite) TestStringWithGoal() {
+	summary, err := DailySummary([]string{"6000,Бег,1h00m", "2700,Ходьба,30m"}, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got := summary.StringWithGoal(Goal{StepsTarget: 10000, CaloriesTarget: 500})
+	assert.Equal(suite.T(), summary.String()+"Выполнено: 87% шагов, 87% калорий.\n", got)
+
+	got = summary.StringWithGoal(Goal{StepsTarget: 10000})
+	assert.Equal(suite.T(), summary.String()+"Выполнено: 87% шагов.\n", got)
+
+	got = summary.StringWithGoal(Goal{CaloriesTarget: 300})
+	assert.Equal(suite.T(), summary.String()+"Выполнено: 145% калорий.\n", got)
+
+	assert.Equal(suite.T(), summary.String(), summary.StringWithGoal(Goal{}))
+}
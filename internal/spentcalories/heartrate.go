@@ -0,0 +1,70 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// Допустимые границы среднего пульса в ударах в минуту.
+const (
+	minHeartRate = 30
+	maxHeartRate = 230
+)
+
+// keytelKcalPerMin возвращает расход в ккал/мин по формуле Keytel et al. (2005)
+// для пульса hr, веса weight (кг.), возраста age и пола sex.
+// Для Unspecified берется среднее формул для мужчин и женщин.
+func keytelKcalPerMin(hr int, weight float64, age int, sex Sex) float64 {
+	h, w, a := float64(hr), weight, float64(age)
+
+	switch sex {
+	case Male:
+		return (-55.0969 + 0.6309*h + 0.1988*w + 0.2017*a) / jInKcal * 1000
+	case Female:
+		return (-20.4022 + 0.4472*h - 0.1263*w + 0.074*a) / jInKcal * 1000
+	default:
+		return (keytelKcalPerMin(hr, weight, age, Male) + keytelKcalPerMin(hr, weight, age, Female)) / 2
+	}
+}
+
+// SpentCaloriesHR принимает:
+// avgHR int — средний пульс за тренировку (уд/мин), от 30 до 230.
+// weight float64 — вес пользователя (кг.).
+// age int — возраст пользователя в полных годах.
+// sex Sex — пол пользователя; для Unspecified берется среднее формул для обоих полов.
+// duration time.Duration — продолжительность тренировки.
+//
+// Калории считаются по формуле Keytel et al. (2005), которая по пульсу
+// точнее оценок по скорости.
+//
+// Возвращает:
+// float64 — количество потраченных калорий.
+// error — ошибку, если входные параметры некорректны.
+func SpentCaloriesHR(avgHR int, weight float64, age int, sex Sex, duration time.Duration) (float64, error) {
+	if avgHR < minHeartRate || avgHR > maxHeartRate {
+		return 0.0, fmt.Errorf("heart rate %d is out of range: expected %d-%d", avgHR, minHeartRate, maxHeartRate)
+	}
+
+	if weight <= 0 {
+		return 0.0, ErrNonPositiveWeight
+	}
+
+	if age <= 0 {
+		return 0.0, fmt.Errorf("age is not positive: %d", age)
+	}
+
+	if sex != Unspecified && sex != Male && sex != Female {
+		return 0.0, fmt.Errorf("unknown sex: %d", sex)
+	}
+
+	if duration <= 0 {
+		return 0.0, ErrNonPositiveDuration
+	}
+
+	calories := keytelKcalPerMin(avgHR, weight, age, sex) * duration.Minutes()
+	if calories <= 0 {
+		return 0.0, fmt.Errorf("heart rate %d is too low for the formula", avgHR)
+	}
+
+	return calories, nil
+}
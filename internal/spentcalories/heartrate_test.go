@@ -0,0 +1,68 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestSpentCaloriesHR() {
+	tests := []struct {
+		name    string
+		hr      int
+		weight  float64
+		age     int
+		sex     Sex
+		want    float64
+		wantErr string
+	}{
+		{name: "мужчина", hr: 156, weight: 75, age: 30, sex: Male, want: 614.57},
+		{name: "женщина", hr: 156, weight: 75, age: 30, sex: Female, want: 402.57},
+		{name: "пол не указан", hr: 156, weight: 75, age: 30, sex: Unspecified, want: 508.57},
+		{name: "пульс ниже 30", hr: 29, weight: 75, age: 30, sex: Male, wantErr: "heart rate 29 is out of range: expected 30-230"},
+		{name: "пульс выше 230", hr: 231, weight: 75, age: 30, sex: Male, wantErr: "heart rate 231 is out of range: expected 30-230"},
+		{name: "неизвестный пол", hr: 156, weight: 75, age: 30, sex: Sex(7), wantErr: "unknown sex: 7"},
+		{name: "нулевой вес", hr: 156, weight: 0, age: 30, sex: Male, wantErr: ErrNonPositiveWeight.Error()},
+		{name: "нулевой возраст", hr: 156, weight: 75, age: 0, sex: Male, wantErr: "age is not positive: 0"},
+		{name: "слишком низкий пульс для формулы", hr: 40, weight: 50, age: 20, sex: Male, wantErr: "too low"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := SpentCaloriesHR(tt.hr, tt.weight, tt.age, tt.sex, 40*time.Minute)
+
+			if tt.wantErr != "" {
+				assert.ErrorContains(suite.T(), err, tt.wantErr)
+				assert.Equal(suite.T(), 0.0, got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 0.01)
+		})
+	}
+
+	_, err := SpentCaloriesHR(156, 75, 30, Male, 0)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDuration)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoHeartRate() {
+	got, err := TrainingInfo("5600,Бег,40m,156", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Тип тренировки: Бег\n")
+	assert.Contains(suite.T(), got, "Сожгли калорий: 508.57\n")
+	assert.Contains(suite.T(), got, "Средний пульс: 156 уд/мин\n")
+
+	t, err := ParseTrainingData("5600,Ходьба,40m,110", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 110, t.HeartRate)
+
+	withoutHR, err := TrainingInfo("5600,Бег,40m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), withoutHR, "пульс")
+
+	for _, input := range []string{"5600,Бег,40m,250", "5600,Бег,40m,12", "5600,Ходьба,40m,110,terrain=snow"} {
+		_, err = TrainingInfo(input, 75.0, 1.75)
+		assert.Error(suite.T(), err, input)
+	}
+}
@@ -0,0 +1,72 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// Константы для поправки калорий на уклон.
+const (
+	maxGrade = 50.0 // максимальный по модулю уклон в процентах.
+
+	uphillFactor       = 0.05 // прирост нагрузки на каждый процент подъема.
+	downhillFactor     = 0.02 // снижение нагрузки на каждый процент спуска.
+	minGradeMultiplier = 0.8  // минимальный коэффициент: на крутом спуске приходится тормозить.
+)
+
+// GradeMultiplier принимает:
+// gradePercent float64 — уклон в процентах: положительный для подъема,
+// отрицательный для спуска.
+//
+// На подъеме нагрузка растет на 5% на каждый процент уклона, на спуске
+// снижается на 2% на каждый процент, но не больше чем до 0.8:
+// на крутом спуске приходится тормозить. Для ровной дороги коэффициент равен 1.
+//
+// Возвращает:
+// float64 — коэффициент, на который умножаются калории.
+// error — ошибку, обернутую в ErrGradeOutOfRange, если уклон вне диапазона от -50% до 50%.
+func GradeMultiplier(gradePercent float64) (float64, error) {
+	if !(gradePercent >= -maxGrade && gradePercent <= maxGrade) {
+		return 0, fmt.Errorf("%w: %g%%, expected %g%% to %g%%", ErrGradeOutOfRange, gradePercent, -maxGrade, maxGrade)
+	}
+
+	if gradePercent >= 0 {
+		return 1 + gradePercent*uphillFactor, nil
+	}
+
+	return max(1+gradePercent*downhillFactor, minGradeMultiplier), nil
+}
+
+// WalkingSpentCaloriesIncline — то же, что WalkingSpentCalories, но калории
+// умножаются на поправку на уклон gradePercent в процентах, см. GradeMultiplier.
+// Для уклона 0% результат совпадает с WalkingSpentCalories.
+func WalkingSpentCaloriesIncline(steps int, weight, height float64, duration time.Duration, gradePercent float64) (float64, error) {
+	multiplier, err := GradeMultiplier(gradePercent)
+	if err != nil {
+		return 0.0, err
+	}
+
+	calories, err := WalkingCalories(steps, Kilograms(weight), Metres(height), duration)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return float64(calories) * multiplier, nil
+}
+
+// RunningSpentCaloriesIncline — то же, что RunningSpentCalories, но калории
+// умножаются на поправку на уклон gradePercent в процентах, см. GradeMultiplier.
+// Для уклона 0% результат совпадает с RunningSpentCalories.
+func RunningSpentCaloriesIncline(steps int, weight, height float64, duration time.Duration, gradePercent float64) (float64, error) {
+	multiplier, err := GradeMultiplier(gradePercent)
+	if err != nil {
+		return 0.0, err
+	}
+
+	calories, err := RunningCalories(steps, Kilograms(weight), Metres(height), duration)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return float64(calories) * multiplier, nil
+}
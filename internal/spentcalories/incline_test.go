@@ -0,0 +1,69 @@
+package spentcalories
+
+import (
+	"math"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestGradeMultiplier() {
+	tests := []struct {
+		name  string
+		grade float64
+		want  float64
+	}{
+		{name: "ровная дорога", grade: 0, want: 1.0},
+		{name: "подъем 10%", grade: 10, want: 1.5},
+		{name: "подъем 50%", grade: 50, want: 3.5},
+		{name: "спуск 5%", grade: -5, want: 0.9},
+		{name: "спуск 10%", grade: -10, want: 0.8},
+		{name: "крутой спуск", grade: -50, want: 0.8},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := GradeMultiplier(tt.grade)
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+
+	for _, grade := range []float64{-50.1, 50.1, 100, math.NaN(), math.Inf(1)} {
+		_, err := GradeMultiplier(grade)
+		assert.ErrorIs(suite.T(), err, ErrGradeOutOfRange, "%v", grade)
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestSpentCaloriesIncline() {
+	flatWalking, err := WalkingSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+
+	got, err := WalkingSpentCaloriesIncline(6000, 75.0, 1.75, time.Hour, 0)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), flatWalking, got)
+
+	got, err = WalkingSpentCaloriesIncline(6000, 75.0, 1.75, time.Hour, 10)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), flatWalking*1.5, got, 1e-9)
+
+	flatRunning, err := RunningSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+
+	got, err = RunningSpentCaloriesIncline(6000, 75.0, 1.75, time.Hour, 0)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), flatRunning, got)
+
+	got, err = RunningSpentCaloriesIncline(6000, 75.0, 1.75, time.Hour, -5)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), flatRunning*0.9, got, 1e-9)
+
+	_, err = WalkingSpentCaloriesIncline(6000, 75.0, 1.75, time.Hour, 60)
+	assert.ErrorIs(suite.T(), err, ErrGradeOutOfRange)
+
+	_, err = RunningSpentCaloriesIncline(6000, 75.0, 1.75, time.Hour, -60)
+	assert.ErrorIs(suite.T(), err, ErrGradeOutOfRange)
+
+	_, err = WalkingSpentCaloriesIncline(0, 75.0, 1.75, time.Hour, 5)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+}
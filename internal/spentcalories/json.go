@@ -0,0 +1,163 @@
+package spentcalories
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+)
+
+// trainingJSON — представление Training в JSON.
+//
+// Продолжительность и темп хранятся в секундах, а не в наносекундах time.Duration.
+type trainingJSON struct {
+	Steps    int     `json:"steps"`
+	Activity string  `json:"activity"`
+	Duration float64 `json:"duration_seconds"`
+	Distance float64 `json:"distance_km"`
+	Speed    float64 `json:"speed_kmh"`
+	Pace     float64 `json:"pace_seconds_per_km"`
+	Cadence  float64 `json:"cadence_spm"`
+	Calories float64 `json:"calories"`
+	Terrain  string  `json:"terrain,omitempty"`
+
+	HeartRate int `json:"heart_rate,omitempty"`
+}
+
+// MarshalJSON реализует json.Marshaler.
+func (t Training) MarshalJSON() ([]byte, error) {
+	return json.Marshal(trainingJSON{
+		Steps:    t.Steps,
+		Activity: t.Activity,
+		Duration: t.Duration.Seconds(),
+		Distance: t.Distance,
+		Speed:    t.Speed,
+		Pace:     t.Pace.Seconds(),
+		Cadence:  t.Cadence,
+		Calories: t.Calories,
+		Terrain:  t.Terrain,
+
+		HeartRate: t.HeartRate,
+	})
+}
+
+// UnmarshalJSON реализует json.Unmarshaler.
+func (t *Training) UnmarshalJSON(data []byte) error {
+	var v trainingJSON
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*t = Training{
+		Steps:    v.Steps,
+		Activity: v.Activity,
+		Duration: time.Duration(math.Round(v.Duration * float64(time.Second))),
+		Distance: v.Distance,
+		Speed:    v.Speed,
+		Pace:     time.Duration(math.Round(v.Pace * float64(time.Second))),
+		Cadence:  v.Cadence,
+		Calories: v.Calories,
+		Terrain:  v.Terrain,
+
+		HeartRate: v.HeartRate,
+	}
+
+	return nil
+}
+
+// TrainingInfoJSON — то же, что TrainingInfo, но возвращает данные
+// о тренировке в формате JSON.
+func TrainingInfoJSON(data string, weight, height float64) ([]byte, error) {
+	t, err := ParseTrainingData(data, weight, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(t)
+}
+
+// trainingInputJSON — запись о тренировке во входном JSON,
+// например {"steps":3456,"activity":"Ходьба","duration":"3h00m"}.
+type trainingInputJSON struct {
+	Steps    *int   `json:"steps"`
+	Activity string `json:"activity"`
+	Duration string `json:"duration"`
+}
+
+// TrainingInfoFromJSON — то же, что TrainingInfo, но запись о тренировке
+// передается в формате JSON: {"steps":3456,"activity":"Ходьба","duration":"3h00m"}.
+// Продолжительность записывается в том же формате, что и в строке, см. ParseDuration.
+func TrainingInfoFromJSON(jsonData []byte, weight, height float64) (string, error) {
+	var v trainingInputJSON
+	if err := json.Unmarshal(jsonData, &v); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrBadDataFormat, err)
+	}
+
+	switch {
+	case v.Steps == nil:
+		return "", fmt.Errorf("%w: missing field %q", ErrBadDataFormat, "steps")
+	case v.Activity == "":
+		return "", fmt.Errorf("%w: missing field %q", ErrBadDataFormat, "activity")
+	case v.Duration == "":
+		return "", fmt.Errorf("%w: missing field %q", ErrBadDataFormat, "duration")
+	}
+
+	if err := checkTrainingSteps(*v.Steps, v.Activity, false); err != nil {
+		return "", err
+	}
+
+	d, err := ParseDuration(v.Duration)
+	if err != nil {
+		return "", fmt.Errorf("%w: failed to extract duration: %w", ErrBadDataFormat, err)
+	}
+
+	rec, err := newTrainingRecord(*v.Steps, v.Activity, d, false)
+	if err != nil {
+		return "", err
+	}
+
+	return rec.info(weight, height)
+}
+
+// trainingReportJSON — представление Training в JSON для MarshalTrainingInfo:
+// продолжительность и темп в минутах, все числа округлены до двух знаков.
+type trainingReportJSON struct {
+	Steps    int     `json:"steps"`
+	Activity string  `json:"activity"`
+	Duration float64 `json:"duration_minutes"`
+	Distance float64 `json:"distance_km"`
+	Speed    float64 `json:"speed_kmh"`
+	Pace     float64 `json:"pace_min_per_km"`
+	Cadence  float64 `json:"cadence_spm"`
+	Calories float64 `json:"calories"`
+	Terrain  string  `json:"terrain,omitempty"`
+}
+
+// MarshalTrainingInfo — то же, что TrainingInfoJSON, но продолжительность
+// и темп выводятся в минутах, а числа округляются до двух знаков после запятой
+// через RoundTo с RoundHalfUp: скорость 4.725 км/ч записывается как 4.73.
+// Для некорректных данных возвращается та же ошибка,
+// что и у TrainingInfo, и nil вместо JSON.
+func MarshalTrainingInfo(data string, weight, height float64) ([]byte, error) {
+	t, err := ParseTrainingData(data, weight, height)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(trainingReportJSON{
+		Steps:    t.Steps,
+		Activity: t.Activity,
+		Duration: round2(t.Duration.Minutes()),
+		Distance: round2(t.Distance),
+		Speed:    round2(t.Speed),
+		Pace:     round2(t.Pace.Minutes()),
+		Cadence:  round2(t.Cadence),
+		Calories: round2(t.Calories),
+		Terrain:  t.Terrain,
+	})
+}
+
+// round2 округляет v до двух знаков после запятой, см. RoundTo.
+func round2(v float64) float64 {
+	return RoundTo(v, 2, RoundHalfUp)
+}
@@ -0,0 +1,97 @@
+package spentcalories
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoJSON() {
+	got, err := TrainingInfoJSON("3000,Бег,30m", 75.0, 1.75)
+
+	assert.NoError(suite.T(), err)
+	assert.JSONEq(suite.T(), `{
+		"steps": 3000,
+		"activity": "Бег",
+		"duration_seconds": 1800,
+		"distance_km": 2.3625,
+		"speed_kmh": 4.725,
+		"pace_seconds_per_km": 761.904761904,
+		"cadence_spm": 100,
+		"calories": 177.1875
+	}`, string(got))
+
+	_, err = TrainingInfoJSON("3000,Йога,30m", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingJSONRoundTrip() {
+	want, err := ParseTrainingData("6000,Ходьба,1h30m15s,terrain=sand", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	data, err := json.Marshal(want)
+	assert.NoError(suite.T(), err)
+
+	var got Training
+	assert.NoError(suite.T(), json.Unmarshal(data, &got))
+	assert.Equal(suite.T(), want, got)
+	assert.Equal(suite.T(), 90*time.Minute+15*time.Second, got.Duration)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoFromJSON() {
+	got, err := TrainingInfoFromJSON([]byte(`{"steps":3456,"activity":"Ходьба","duration":"3h00m"}`), 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	want, err := TrainingInfo("3456,Ходьба,3h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "некорректный JSON", input: `{"steps":3456,`, wantErr: "unexpected end of JSON input"},
+		{name: "шаги строкой", input: `{"steps":"3456","activity":"Ходьба","duration":"3h"}`, wantErr: "cannot unmarshal"},
+		{name: "нет шагов", input: `{"activity":"Ходьба","duration":"3h"}`, wantErr: `missing field "steps"`},
+		{name: "нет активности", input: `{"steps":3456,"duration":"3h"}`, wantErr: `missing field "activity"`},
+		{name: "нет продолжительности", input: `{"steps":3456,"activity":"Ходьба"}`, wantErr: `missing field "duration"`},
+		{name: "некорректная продолжительность", input: `{"steps":3456,"activity":"Ходьба","duration":"abc"}`, wantErr: "failed to extract duration"},
+		{name: "нулевые шаги", input: `{"steps":0,"activity":"Ходьба","duration":"3h"}`, wantErr: ErrNonPositiveSteps.Error()},
+		{name: "неизвестная активность", input: `{"steps":3456,"activity":"Йога","duration":"3h"}`, wantErr: ErrUnknownActivity.Error()},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoFromJSON([]byte(tt.input), 75.0, 1.75)
+			assert.ErrorContains(suite.T(), err, tt.wantErr)
+			assert.Empty(suite.T(), got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestMarshalTrainingInfo() {
+	got, err := MarshalTrainingInfo("3000,Бег,30m", 75.0, 1.75)
+
+	assert.NoError(suite.T(), err)
+	assert.JSONEq(suite.T(), `{
+		"steps": 3000,
+		"activity": "Бег",
+		"duration_minutes": 30,
+		"distance_km": 2.36,
+		"speed_kmh": 4.73,
+		"pace_min_per_km": 12.7,
+		"cadence_spm": 100,
+		"calories": 177.19
+	}`, string(got))
+
+	got, err = MarshalTrainingInfo("3000,Йога,30m", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+	assert.Nil(suite.T(), got)
+
+	_, wantErr := TrainingInfo("abc,Бег,30m", 75.0, 1.75)
+	got, err = MarshalTrainingInfo("abc,Бег,30m", 75.0, 1.75)
+	assert.EqualError(suite.T(), err, wantErr.Error())
+	assert.Nil(suite.T(), got)
+}
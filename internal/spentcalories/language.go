@@ -0,0 +1,57 @@
+package spentcalories
+
+import (
+	"fmt"
+	"text/template"
+)
+
+// Language — язык отчета TrainingInfoLang.
+type Language int
+
+const (
+	Russian Language = iota // русский, как в TrainingInfo.
+	English                 // английский.
+)
+
+// locale — шаблон отчета и названия видов активности на одном языке.
+type locale struct {
+	template   *template.Template
+	activities map[string]string // перевод канонических названий, nil — без перевода.
+}
+
+// locales — поддерживаемые языки отчета. Чтобы добавить язык, достаточно
+// объявить для него константу Language, шаблон и запись в этой таблице.
+var locales = map[Language]locale{
+	Russian: {template: TrainingTemplateRU},
+	English: {
+		template: TrainingTemplateEN,
+		activities: map[string]string{
+			"Бег":       "Running",
+			"Ходьба":    "Walking",
+			"Велоспорт": "Cycling",
+			"Велосипед": "Cycling",
+			"Плавание":  "Swimming",
+		},
+	},
+}
+
+// TrainingInfoLang — то же, что TrainingInfo, но отчет выводится на языке lang.
+// Название вида активности тоже переводится: для English "Бег" — "Running".
+// Вывод для Russian совпадает с TrainingInfo.
+func TrainingInfoLang(data string, weight, height float64, lang Language) (string, error) {
+	loc, ok := locales[lang]
+	if !ok {
+		return "", fmt.Errorf("unknown language: %d", lang)
+	}
+
+	t, err := ParseTrainingData(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	if name, ok := loc.activities[canonicalActivity(t.Activity)]; ok {
+		t.Activity = name
+	}
+
+	return t.render(loc.template)
+}
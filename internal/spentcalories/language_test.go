@@ -0,0 +1,44 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoLang() {
+	ru, err := TrainingInfoLang("6000,Бег,1h00m", 75.0, 1.75, Russian)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 354.38\n", ru)
+
+	en, err := TrainingInfoLang("6000,Бег,1h00m", 75.0, 1.75, English)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Activity type: Running\nDuration: 1.00 h\nDistance: 4.72 km\nSpeed: 4.72 km/h\nPace: 12:42 min/km\nCadence: 100.00 spm\nCalories burned: 354.38\n", en)
+
+	en, err = TrainingInfoLang("40,плавание,45m,50", 75.0, 1.75, English)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), en, "Activity type: Swimming\n")
+
+	_, err = TrainingInfoLang("6000,Бег,1h00m", 75.0, 1.75, Language(5))
+	assert.EqualError(suite.T(), err, "unknown language: 5")
+
+	_, err = TrainingInfoLang("6000,Йога,1h00m", 75.0, 1.75, English)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoLangMatchesTrainingInfo() {
+	for _, input := range []string{"6000,Бег,1h00m", "6000,Ходьба,1h00m,terrain=snow", "5600,Бег,40m,156", "0,Велоспорт,1h30m,25.4", "40,Плавание,45m,50"} {
+		want, err := TrainingInfo(input, 75.0, 1.75)
+		assert.NoError(suite.T(), err)
+
+		got, err := TrainingInfoLang(input, 75.0, 1.75, Russian)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), want, got, input)
+
+		en, err := TrainingInfoLang(input, 75.0, 1.75, English)
+		assert.NoError(suite.T(), err)
+		assert.Contains(suite.T(), en, "Calories burned: ", input)
+	}
+
+	en, err := TrainingInfoLang("0,Велоспорт,1h30m,25.4", 75.0, 1.75, English)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), en, "Activity type: Cycling\nDuration: 1.50 h\nDistance: 25.40 km\n")
+}
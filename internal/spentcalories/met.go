@@ -0,0 +1,80 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// Значения MET для ходьбы по таблицам Compendium of Physical Activities.
+const (
+	walkingMETSlow     = 2.9 // медленная ходьба, до 4 км/ч.
+	walkingMETModerate = 3.5 // обычная ходьба, от 4 до 5.5 км/ч.
+	walkingMETBrisk    = 4.3 // быстрая ходьба, больше 5.5 км/ч.
+
+	walkingSlowMaxKmh     = 4.0 // верхняя граница медленной ходьбы в км/ч.
+	walkingModerateMaxKmh = 5.5 // верхняя граница обычной ходьбы в км/ч.
+)
+
+// walkingMET возвращает MET ходьбы со скоростью speedKmh.
+func walkingMET(speedKmh float64) float64 {
+	switch {
+	case speedKmh < walkingSlowMaxKmh:
+		return walkingMETSlow
+	case speedKmh <= walkingModerateMaxKmh:
+		return walkingMETModerate
+	default:
+		return walkingMETBrisk
+	}
+}
+
+// runningMET возвращает MET бега со скоростью speedKmh. Бег обходится
+// примерно в 1 ккал на килограмм веса за километр, поэтому MET численно
+// равен скорости в км/ч.
+func runningMET(speedKmh float64) float64 {
+	return speedKmh
+}
+
+// metSpeed проверяет шаги, рост и продолжительность и возвращает
+// среднюю скорость в км/ч.
+func metSpeed(steps int, height float64, duration time.Duration) (float64, error) {
+	if steps <= 0 {
+		return 0.0, fmt.Errorf("%w: %d", ErrNonPositiveSteps, steps)
+	}
+
+	if err := checkSteps(steps); err != nil {
+		return 0.0, err
+	}
+
+	if height <= 0 {
+		return 0.0, ErrNonPositiveHeight
+	}
+
+	if duration <= 0 {
+		return 0.0, ErrNonPositiveDuration
+	}
+
+	return meanSpeed(steps, height, duration), nil
+}
+
+// RunningSpentCaloriesMET — то же, что RunningSpentCalories, но калории
+// считаются по MET (см. CaloriesByMET): MET бега равен скорости в км/ч.
+func RunningSpentCaloriesMET(steps int, weight, height float64, duration time.Duration) (float64, error) {
+	speed, err := metSpeed(steps, height, duration)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return CaloriesByMET(runningMET(speed), weight, duration)
+}
+
+// WalkingSpentCaloriesMET — то же, что WalkingSpentCalories, но калории
+// считаются по MET (см. CaloriesByMET): 2.9 при скорости до 4 км/ч,
+// 3.5 — до 5.5 км/ч и 4.3 — быстрее.
+func WalkingSpentCaloriesMET(steps int, weight, height float64, duration time.Duration) (float64, error) {
+	speed, err := metSpeed(steps, height, duration)
+	if err != nil {
+		return 0.0, err
+	}
+
+	return CaloriesByMET(walkingMET(speed), weight, duration)
+}
@@ -0,0 +1,84 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestWalkingSpentCaloriesMET() {
+	tests := []struct {
+		name     string
+		steps    int
+		duration time.Duration
+		want     float64
+	}{
+		// при росте 1.75 длина шага 0.7875 м.
+		{name: "медленно - 3.15 км/ч", steps: 4000, duration: time.Hour, want: 2.9 * 70},
+		{name: "обычно - 5 км/ч", steps: 6349, duration: time.Hour, want: 245},
+		{name: "быстро - 6.3 км/ч", steps: 8000, duration: time.Hour, want: 4.3 * 70},
+		{name: "обычно - полчаса", steps: 3000, duration: 30 * time.Minute, want: 3.5 * 70 / 2},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := WalkingSpentCaloriesMET(tt.steps, 70.0, 1.75, tt.duration)
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 0.01)
+		})
+	}
+
+	_, err := WalkingSpentCaloriesMET(0, 70.0, 1.75, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+	_, err = WalkingSpentCaloriesMET(6000, 0, 1.75, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveWeight)
+	_, err = WalkingSpentCaloriesMET(6000, 70.0, 0, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveHeight)
+	_, err = WalkingSpentCaloriesMET(6000, 70.0, 1.75, 0)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDuration)
+}
+
+func (suite *SpentCaloriesTestSuite) TestRunningSpentCaloriesMET() {
+	// 10000 шагов за час при росте 1.75 — 7.875 км/ч, то есть 7.875 MET.
+	got, err := RunningSpentCaloriesMET(10000, 70.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 551.25, got, 0.01)
+
+	_, err = RunningSpentCaloriesMET(10000, 70.0, 1.75, 0)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDuration)
+	_, err = RunningSpentCaloriesMET(-1, 70.0, 1.75, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+}
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesByMETCompendium() {
+	tests := []struct {
+		name     string
+		met      float64
+		weight   float64
+		duration time.Duration
+		want     float64
+		wantErr  error
+	}{
+		// значения MET — Compendium of Physical Activities (2011).
+		{name: "хатха-йога, 60 кг, 30 минут", met: 2.5, weight: 60, duration: 30 * time.Minute, want: 75},
+		{name: "гребля умеренная, 80 кг, 45 минут", met: 7.0, weight: 80, duration: 45 * time.Minute, want: 420},
+		{name: "ходьба 5 км/ч, 70 кг, час", met: 3.5, weight: 70, duration: time.Hour, want: 245},
+		{name: "бег 10 км/ч, 75 кг, 1.5 часа", met: 9.8, weight: 75, duration: 90 * time.Minute, want: 1102.5},
+		{name: "нулевой вес", met: 3.5, weight: 0, duration: time.Hour, wantErr: ErrNonPositiveWeight},
+		{name: "отрицательный вес", met: 3.5, weight: -70, duration: time.Hour, wantErr: ErrNonPositiveWeight},
+		{name: "нулевая продолжительность", met: 3.5, weight: 70, duration: 0, wantErr: ErrNonPositiveDuration},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := CaloriesByMET(tt.met, tt.weight, tt.duration)
+			if tt.wantErr != nil {
+				assert.ErrorIs(suite.T(), err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strings"
+)
+
+// segmentSep — разделитель отрезков интервальной тренировки.
+const segmentSep = "|"
+
+// TrainingInfoMulti принимает:
+// data string — отрезки интервальной тренировки в формате TrainingInfo,
+// разделенные '|': "2000,Ходьба,0h20m|5000,Бег,0h30m".
+// weight, height float64 — вес (кг.) и рост (м.) пользователя.
+//
+// Каждый отрезок разбирается и проверяется отдельно. Шаги, продолжительность,
+// дистанция и калории отрезков складываются, скорость, темп и каденс
+// считаются по суммам, а виды активности перечисляются через " + ".
+// Тренировка из одного отрезка выводится так же, как в TrainingInfo.
+//
+// Возвращает:
+// string — информацию о тренировке в формате TrainingInfo.
+// error — ошибку первого ошибочного отрезка с его индексом.
+func TrainingInfoMulti(data string, weight, height float64) (string, error) {
+	segments := strings.Split(data, segmentSep)
+
+	trainings := make([]Training, 0, len(segments))
+	for i, segment := range segments {
+		t, err := ParseTrainingData(segment, weight, height)
+		if err != nil {
+			return "", fmt.Errorf("segment %d: %w", i, err)
+		}
+
+		trainings = append(trainings, t)
+	}
+
+	if len(trainings) == 1 {
+		return trainings[0].String(), nil
+	}
+
+	var (
+		total      Training
+		activities []string
+	)
+
+	for _, t := range trainings {
+		total.Steps += t.Steps
+		total.Duration += t.Duration
+		total.Distance += t.Distance
+		total.Calories += t.Calories
+		activities = append(activities, t.Activity)
+	}
+
+	total.Activity = strings.Join(activities, " + ")
+	total.Speed = total.Distance / total.Duration.Hours()
+	total.Pace = pace(total.Distance, total.Duration)
+	total.Cadence = Cadence(total.Steps, total.Duration)
+
+	return total.String(), nil
+}
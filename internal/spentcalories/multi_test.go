@@ -0,0 +1,51 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoMulti() {
+	got, err := TrainingInfoMulti("2000,Ходьба,0h20m|5000,Бег,0h30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	walk, err := ParseTrainingData("2000,Ходьба,0h20m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	run, err := ParseTrainingData("5000,Бег,0h30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	// 7000 шагов — 5.51 км за 50 минут.
+	assert.InDelta(suite.T(), 354.375, walk.Calories+run.Calories, 1e-9)
+	assert.Equal(suite.T(), "Тип тренировки: Ходьба + Бег\nДлительность: 0.83 ч.\nДистанция: 5.51 км.\nСкорость: 6.62 км/ч\nТемп: 9:04 мин/км\nКаденс: 140.00 шаг/мин\nСожгли калорий: 354.38\n", got)
+
+	// один отрезок — как TrainingInfo.
+	want, err := TrainingInfo("6000,Ходьба,1h00m,terrain=snow", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	got, err = TrainingInfoMulti("6000,Ходьба,1h00m,terrain=snow", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	got, err = TrainingInfoMulti("2000,Ходьба,0h20m|0,Велоспорт,1h,25", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Тип тренировки: Ходьба + Велоспорт\n")
+	assert.Contains(suite.T(), got, "Дистанция: 26.57 км.\n")
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr error
+		wantMsg string
+	}{
+		{name: "ошибка во втором отрезке", input: "2000,Ходьба,0h20m|5000,Йога,0h30m", wantErr: ErrUnknownActivity, wantMsg: "segment 1:"},
+		{name: "ошибка в первом отрезке", input: "0,Ходьба,0h20m|5000,Бег,0h30m", wantErr: ErrNonPositiveSteps, wantMsg: "segment 0:"},
+		{name: "пустой отрезок", input: "2000,Ходьба,0h20m||5000,Бег,0h30m", wantErr: ErrBadDataFormat, wantMsg: "segment 1:"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoMulti(tt.input, 75.0, 1.75)
+			assert.ErrorIs(suite.T(), err, tt.wantErr)
+			assert.ErrorContains(suite.T(), err, tt.wantMsg)
+			assert.Empty(suite.T(), got)
+		})
+	}
+}
@@ -0,0 +1,82 @@
+package spentcalories
+
+// ReportOptions — настройки текстового отчета, которые задаются через Option.
+type ReportOptions struct {
+	Format       FormatOptions // формат чисел.
+	HideSpeed    bool          // не выводить скорость.
+	HideDistance bool          // не выводить дистанцию.
+	Compact      bool          // выводить отчет одной строкой.
+}
+
+// defaultReport — настройки, с которыми отчет совпадает с TrainingInfo.
+var defaultReport = ReportOptions{Format: DefaultFormat}
+
+// Option изменяет настройки отчета, см. TrainingInfoOpts.
+type Option func(*ReportOptions)
+
+// WithPrecision задает количество знаков после точки, по умолчанию 2.
+func WithPrecision(n int) Option {
+	return func(r *ReportOptions) {
+		r.Format.Decimals = n
+	}
+}
+
+// WithoutSpeed убирает из отчета скорость.
+func WithoutSpeed() Option {
+	return func(r *ReportOptions) {
+		r.HideSpeed = true
+	}
+}
+
+// WithoutDistance убирает из отчета дистанцию.
+func WithoutDistance() Option {
+	return func(r *ReportOptions) {
+		r.HideDistance = true
+	}
+}
+
+// WithCompactFormat выводит отчет одной строкой без темпа, каденса
+// и дополнительных строк:
+//
+//	Ходьба: 1.00 ч., 4.72 км., 4.72 км/ч, 177.19 ккал
+func WithCompactFormat() Option {
+	return func(r *ReportOptions) {
+		r.Compact = true
+	}
+}
+
+// NewReportOptions применяет opts к настройкам по умолчанию и проверяет
+// результат. Пригодится пакетам, которые выводят собственные отчеты
+// с теми же Option, например daysteps.
+func NewReportOptions(opts ...Option) (ReportOptions, error) {
+	r := defaultReport
+	for _, opt := range opts {
+		opt(&r)
+	}
+
+	if err := r.Format.Validate(); err != nil {
+		return ReportOptions{}, err
+	}
+
+	return r, nil
+}
+
+// TrainingInfoOpts — то же, что TrainingInfo, но отчет настраивается
+// через opts, например:
+//
+//	TrainingInfoOpts(data, weight, height, WithPrecision(1), WithoutSpeed())
+//
+// Без opts вывод совпадает с TrainingInfo.
+func TrainingInfoOpts(data string, weight, height float64, opts ...Option) (string, error) {
+	r, err := NewReportOptions(opts...)
+	if err != nil {
+		return "", err
+	}
+
+	t, err := ParseTrainingData(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	return t.format(Metric, Kilocalories, r), nil
+}
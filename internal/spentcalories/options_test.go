@@ -0,0 +1,59 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoOpts() {
+	const data = "6000,Ходьба,1h00m"
+
+	legacy, err := TrainingInfo(data, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	tests := []struct {
+		name string
+		opts []Option
+		want string
+	}{
+		{name: "по умолчанию", want: legacy},
+		{
+			name: "один знак",
+			opts: []Option{WithPrecision(1)},
+			want: "Тип тренировки: Ходьба\nДлительность: 1.0 ч.\nДистанция: 4.7 км.\nСкорость: 4.7 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.0 шаг/мин\nСожгли калорий: 177.2\n",
+		},
+		{
+			name: "без скорости",
+			opts: []Option{WithoutSpeed()},
+			want: "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 177.19\n",
+		},
+		{
+			name: "без дистанции",
+			opts: []Option{WithoutDistance()},
+			want: "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 177.19\n",
+		},
+		{
+			name: "одной строкой",
+			opts: []Option{WithCompactFormat()},
+			want: "Ходьба: 1.00 ч., 4.72 км., 4.72 км/ч, 177.19 ккал\n",
+		},
+		{
+			name: "комбинация",
+			opts: []Option{WithCompactFormat(), WithoutSpeed(), WithPrecision(1)},
+			want: "Ходьба: 1.0 ч., 4.7 км., 177.2 ккал\n",
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoOpts(data, 75.0, 1.75, tt.opts...)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+
+	_, err = TrainingInfoOpts(data, 75.0, 1.75, WithPrecision(-1))
+	assert.ErrorContains(suite.T(), err, "decimals is negative")
+
+	_, err = TrainingInfoOpts("6000,Ходьба", 75.0, 1.75, WithoutSpeed())
+	assert.ErrorIs(suite.T(), err, ErrBadDataFormat)
+}
@@ -0,0 +1,211 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+)
+
+// Sex — пол пользователя для уточненной модели расхода калорий.
+type Sex int
+
+const (
+	Unspecified Sex = iota // пол не указан, поправка не применяется.
+	Male                   // мужской.
+	Female                 // женский.
+)
+
+// referenceAge — возраст в годах, который подставляется, если возраст не указан.
+const referenceAge = 30
+
+// UserProfile — данные пользователя для уточненной модели расхода калорий.
+type UserProfile struct {
+	Weight Kilograms // вес пользователя.
+	Height Metres    // рост пользователя.
+	Age    int       // возраст в полных годах, 0 — не указан.
+	Sex    Sex       // пол пользователя.
+}
+
+// bmr возвращает базовый обмен в ккал/сут по формуле Миффлина — Сан Жеора.
+// Это единственная формула базового обмена в пакете.
+func bmr(sex Sex, weight Kilograms, height Metres, age int) float64 {
+	base := 10*float64(weight) + 6.25*float64(height)*cmInM - 5*float64(age)
+
+	switch sex {
+	case Male:
+		return base + 5
+	case Female:
+		return base - 161
+	default:
+		return base - 78
+	}
+}
+
+// Validate проверяет профиль: вес должен быть положительным, рост — в метрах
+// (см. ValidateHeight; рост в сантиметрах, например 175, считается ошибкой),
+// а возраст — неотрицательным.
+func (p UserProfile) Validate() error {
+	if p.Weight <= 0 {
+		return ErrNonPositiveWeight
+	}
+
+	if _, err := ValidateHeight(float64(p.Height)); err != nil {
+		if _, _, cmErr := NormalizeHeight(float64(p.Height)); cmErr == nil {
+			return fmt.Errorf("%w: looks like centimetres, use FromCentimetres", err)
+		}
+
+		return err
+	}
+
+	if p.Age < 0 {
+		return fmt.Errorf("age is negative: %d", p.Age)
+	}
+
+	return nil
+}
+
+// CalorieFactor возвращает поправочный коэффициент к расходу калорий
+// для профиля p: отношение его базового обмена к среднему для обоих полов
+// базовому обмену человека того же веса и роста в возрасте 30 лет.
+// Если пол не указан, коэффициент равен 1.
+func (p UserProfile) CalorieFactor() (float64, error) {
+	if p.Age < 0 {
+		return 0, fmt.Errorf("age is negative: %d", p.Age)
+	}
+
+	if p.Sex == Unspecified {
+		return 1, nil
+	}
+
+	age := p.Age
+	if age == 0 {
+		age = referenceAge
+	}
+
+	return bmr(p.Sex, p.Weight, p.Height, age) / bmr(Unspecified, p.Weight, p.Height, referenceAge), nil
+}
+
+// RunningSpentCaloriesProfile принимает:
+// steps int — количество шагов.
+// profile UserProfile — вес, рост, возраст и пол пользователя.
+// duration time.Duration — продолжительность бега.
+//
+// Калории, рассчитанные RunningCalories, умножаются на поправку профиля:
+//
+//	RunningCalories * BMR(пол, вес, рост, возраст) / BMR(вес, рост, 30 лет),
+//
+// где BMR — базовый обмен по формуле Миффлина — Сан Жеора
+// (см. BasalMetabolicRate):
+//
+//	мужчины: 10 * вес(кг) + 6.25 * рост(см) - 5 * возраст + 5
+//	женщины: 10 * вес(кг) + 6.25 * рост(см) - 5 * возраст - 161
+//
+// а в знаменателе — среднее этих формул для обоих полов. Поэтому у мужчин
+// и молодых людей калорий больше, у женщин и пожилых — меньше.
+// Если пол не указан, результат совпадает с RunningCalories.
+//
+// Возвращает:
+// Kcal — количество калорий, потраченных при беге.
+// error — ошибку, если входные параметры некорректны.
+func RunningSpentCaloriesProfile(steps int, profile UserProfile, duration time.Duration) (Kcal, error) {
+	factor, err := profile.CalorieFactor()
+	if err != nil {
+		return 0, err
+	}
+
+	calories, err := RunningCalories(steps, profile.Weight, profile.Height, duration)
+	if err != nil {
+		return 0, err
+	}
+
+	return calories * Kcal(factor), nil
+}
+
+// TrainingInfoFor — то же, что TrainingInfo, но вес и рост берутся
+// из профиля p, а калории умножаются на p.CalorieFactor(). Если калории
+// считаются по пульсу, вместо поправки в формулу Keytel передаются возраст
+// и пол из профиля. Если пол не указан, результат совпадает с TrainingInfo.
+func TrainingInfoFor(data string, p UserProfile) (string, error) {
+	if err := p.Validate(); err != nil {
+		return "", fmt.Errorf("invalid profile: %w", err)
+	}
+
+	factor, err := p.CalorieFactor()
+	if err != nil {
+		return "", err
+	}
+
+	rec, err := parseTrainingRecord(data, recordSep(data))
+	if err != nil {
+		return "", fmt.Errorf("parseTraining: %w", err)
+	}
+
+	rec.age, rec.sex = p.Age, p.Sex
+
+	t, err := rec.training(float64(p.Weight), float64(p.Height))
+	if err != nil {
+		return "", err
+	}
+
+	if t.HeartRate == 0 {
+		t.Calories *= factor
+	}
+
+	return t.String(), nil
+}
+
+// BasalMetabolicRate возвращает базовый обмен пользователя в ккал/сут
+// по формуле Миффлина — Сан Жеора:
+//
+//	10 * вес(кг) + 6.25 * рост(см) - 5 * возраст + 5    — для мужчин,
+//	10 * вес(кг) + 6.25 * рост(см) - 5 * возраст - 161  — для женщин.
+//
+// Если пол не указан, берется среднее двух формул, если не указан возраст — 30 лет.
+func BasalMetabolicRate(profile UserProfile) (float64, error) {
+	if err := profile.Validate(); err != nil {
+		return 0.0, err
+	}
+
+	age := profile.Age
+	if age == 0 {
+		age = referenceAge
+	}
+
+	return bmr(profile.Sex, profile.Weight, profile.Height, age), nil
+}
+
+// TotalDailyEnergy принимает:
+// profile UserProfile — данные пользователя.
+// activityEntries []string — тренировки за день в формате TrainingInfo.
+//
+// Суточный расход складывается из базового обмена за время без тренировок
+// и калорий, потраченных на тренировках (с поправкой CalorieFactor), поэтому
+// базовый обмен за время тренировок не учитывается дважды.
+//
+// Возвращает:
+// float64 — суточный расход энергии в ккал.
+// error — ошибку профиля, первой ошибочной тренировки или превышения суток.
+func TotalDailyEnergy(profile UserProfile, activityEntries []string) (float64, error) {
+	bmr, err := BasalMetabolicRate(profile)
+	if err != nil {
+		return 0.0, err
+	}
+
+	factor, err := profile.CalorieFactor()
+	if err != nil {
+		return 0.0, err
+	}
+
+	summary, err := DailySummary(activityEntries, float64(profile.Weight), float64(profile.Height))
+	if err != nil {
+		return 0.0, err
+	}
+
+	day := 24 * time.Hour
+	if summary.TotalDuration > day {
+		return 0.0, fmt.Errorf("activities last %v, longer than a day", summary.TotalDuration)
+	}
+
+	rest := float64(day-summary.TotalDuration) / float64(day)
+
+	return bmr*rest + summary.TotalCalories*factor, nil
+}
@@ -0,0 +1,166 @@
+package spentcalories
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestRunningSpentCaloriesProfile() {
+	base, err := RunningCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+
+	tests := []struct {
+		name    string
+		profile UserProfile
+		want    Kcal
+	}{
+		{
+			name:    "пол не указан - как RunningCalories",
+			profile: UserProfile{Weight: 75.0, Height: 1.75, Age: 45},
+			want:    base,
+		},
+		{
+			name:    "мужчина 30 лет",
+			profile: UserProfile{Weight: 75.0, Height: 1.75, Age: 30, Sex: Male},
+			want:    372.58,
+		},
+		{
+			name:    "мужчина без возраста - как в 30 лет",
+			profile: UserProfile{Weight: 75.0, Height: 1.75, Sex: Male},
+			want:    372.58,
+		},
+		{
+			name:    "мужчина 60 лет",
+			profile: UserProfile{Weight: 75.0, Height: 1.75, Age: 60, Sex: Male},
+			want:    339.68,
+		},
+		{
+			name:    "женщина 30 лет",
+			profile: UserProfile{Weight: 75.0, Height: 1.75, Age: 30, Sex: Female},
+			want:    336.17,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := RunningSpentCaloriesProfile(6000, tt.profile, time.Hour)
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), float64(tt.want), float64(got), 0.01)
+		})
+	}
+
+	_, err = RunningSpentCaloriesProfile(6000, UserProfile{Weight: 75.0, Height: 1.75, Age: -1, Sex: Male}, time.Hour)
+	assert.ErrorContains(suite.T(), err, "age is negative")
+
+	_, err = RunningSpentCaloriesProfile(6000, UserProfile{Height: 1.75, Sex: Female}, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveWeight)
+}
+
+func (suite *SpentCaloriesTestSuite) TestRunningSpentCaloriesProfileSex() {
+	for _, age := range []int{20, 40, 70} {
+		male, err := RunningSpentCaloriesProfile(6000, UserProfile{Weight: 75.0, Height: 1.75, Age: age, Sex: Male}, time.Hour)
+		assert.NoError(suite.T(), err)
+		female, err := RunningSpentCaloriesProfile(6000, UserProfile{Weight: 75.0, Height: 1.75, Age: age, Sex: Female}, time.Hour)
+		assert.NoError(suite.T(), err)
+
+		// при одинаковых данных мужчины тратят больше, но не более чем на 20%.
+		assert.Greater(suite.T(), male, female)
+		assert.Less(suite.T(), float64(male/female), 1.2)
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestUserProfileValidate() {
+	assert.NoError(suite.T(), UserProfile{Weight: 75.0, Height: 1.75}.Validate())
+	assert.NoError(suite.T(), UserProfile{Weight: 75.0, Height: 1.75, Age: 40, Sex: Female}.Validate())
+
+	assert.ErrorIs(suite.T(), UserProfile{Height: 1.75}.Validate(), ErrNonPositiveWeight)
+	assert.ErrorContains(suite.T(), UserProfile{Weight: 75.0, Height: 1.75, Age: -3}.Validate(), "age is negative")
+
+	err := UserProfile{Weight: 75.0, Height: 175}.Validate()
+	var heightErr *HeightError
+	assert.ErrorAs(suite.T(), err, &heightErr)
+	assert.ErrorContains(suite.T(), err, "looks like centimetres")
+
+	err = UserProfile{Weight: 75.0, Height: 12}.Validate()
+	assert.ErrorAs(suite.T(), err, &heightErr)
+	assert.NotContains(suite.T(), err.Error(), "looks like centimetres")
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoFor() {
+	want, err := TrainingInfo("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got, err := TrainingInfoFor("6000,Бег,1h00m", UserProfile{Weight: 75.0, Height: 1.75})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	got, err = TrainingInfoFor("6000,Бег,1h00m", UserProfile{Weight: 75.0, Height: 1.75, Age: 30, Sex: Male})
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Сожгли калорий: 372.58\n")
+
+	// по пульсу возраст и пол из профиля передаются в формулу Keytel.
+	hr, err := SpentCaloriesHR(156, 75.0, 45, Female, 40*time.Minute)
+	assert.NoError(suite.T(), err)
+
+	got, err = TrainingInfoFor("5600,Бег,40m,156", UserProfile{Weight: 75.0, Height: 1.75, Age: 45, Sex: Female})
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, fmt.Sprintf("Сожгли калорий: %.2f\n", hr))
+
+	want, err = TrainingInfo("5600,Бег,40m,156", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got, err = TrainingInfoFor("5600,Бег,40m,156", UserProfile{Weight: 75.0, Height: 1.75})
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	_, err = TrainingInfoFor("6000,Бег,1h00m", UserProfile{Weight: 1.75, Height: 75.0})
+	assert.ErrorContains(suite.T(), err, "invalid profile")
+}
+
+func (suite *SpentCaloriesTestSuite) TestBasalMetabolicRate() {
+	tests := []struct {
+		name    string
+		profile UserProfile
+		want    float64
+	}{
+		{name: "мужчина", profile: UserProfile{Weight: 75.0, Height: 1.75, Age: 30, Sex: Male}, want: 1698.75},
+		{name: "женщина", profile: UserProfile{Weight: 75.0, Height: 1.75, Age: 30, Sex: Female}, want: 1532.75},
+		{name: "пол не указан", profile: UserProfile{Weight: 75.0, Height: 1.75, Age: 30}, want: 1615.75},
+		{name: "возраст не указан", profile: UserProfile{Weight: 75.0, Height: 1.75, Sex: Male}, want: 1698.75},
+		{name: "мужчина 60 лет", profile: UserProfile{Weight: 75.0, Height: 1.75, Age: 60, Sex: Male}, want: 1548.75},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := BasalMetabolicRate(tt.profile)
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.want, got, 1e-9)
+		})
+	}
+
+	_, err := BasalMetabolicRate(UserProfile{Weight: 75.0, Height: 175})
+	var heightErr *HeightError
+	assert.ErrorAs(suite.T(), err, &heightErr)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTotalDailyEnergy() {
+	profile := UserProfile{Weight: 75.0, Height: 1.75, Age: 30, Sex: Male}
+
+	got, err := TotalDailyEnergy(profile, nil)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 1698.75, got, 1e-9)
+
+	// 23 часа базового обмена и час бега.
+	got, err = TotalDailyEnergy(profile, []string{"6000,Бег,1h00m"})
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 1698.75*23/24+372.58, got, 0.01)
+
+	_, err = TotalDailyEnergy(profile, []string{"6000,Бег,1h00m", "6000,Йога,1h00m"})
+	assert.ErrorContains(suite.T(), err, "entry 1:")
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+
+	_, err = TotalDailyEnergy(profile, []string{"60000,Ходьба,25h"})
+	assert.ErrorContains(suite.T(), err, "longer than a day")
+}
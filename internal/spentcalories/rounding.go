@@ -0,0 +1,68 @@
+package spentcalories
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// RoundMode — способ округления для RoundTo.
+type RoundMode int
+
+const (
+	RoundHalfUp   RoundMode = iota // половина округляется от нуля: 2.675 -> 2.68, -2.675 -> -2.68.
+	RoundHalfEven                  // банковское: половина округляется к четному, 2.665 -> 2.66.
+	RoundFloor                     // вниз, к минус бесконечности.
+	RoundCeil                      // вверх, к плюс бесконечности.
+)
+
+// RoundTo принимает:
+// value float64 — число, например дистанция, скорость или калории из Training.
+// decimals int — количество знаков после запятой, отрицательное — до десятков, сотен и т.д.
+// mode RoundMode — способ округления, неизвестный считается RoundHalfUp.
+//
+// Округление выполняется по десятичной записи числа, поэтому 2.675 с RoundHalfUp
+// дает 2.68, а 4.1 с RoundFloor — 4.1, хотя в float64 они хранятся
+// как 2.67499... и 4.09999...
+//
+// Возвращает:
+// float64 — округленное число, NaN и бесконечности возвращаются без изменений.
+func RoundTo(value float64, decimals int, mode RoundMode) float64 {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return value
+	}
+
+	scaled := shiftDecimal(value, decimals)
+
+	switch mode {
+	case RoundHalfEven:
+		scaled = math.RoundToEven(scaled)
+	case RoundFloor:
+		scaled = math.Floor(scaled)
+	case RoundCeil:
+		scaled = math.Ceil(scaled)
+	default:
+		scaled = math.Round(scaled)
+	}
+
+	return shiftDecimal(scaled, -decimals)
+}
+
+// shiftDecimal возвращает v * 10^n, сдвигая запятую в кратчайшей
+// десятичной записи v, а не умножая в float64.
+func shiftDecimal(v float64, n int) float64 {
+	s := strconv.FormatFloat(v, 'e', -1, 64)
+	mantissa, exp, _ := strings.Cut(s, "e")
+
+	e, err := strconv.Atoi(exp)
+	if err != nil {
+		return v * math.Pow10(n)
+	}
+
+	shifted, err := strconv.ParseFloat(mantissa+"e"+strconv.Itoa(e+n), 64)
+	if err != nil {
+		return v * math.Pow10(n)
+	}
+
+	return shifted
+}
@@ -0,0 +1,52 @@
+package spentcalories
+
+import (
+	"math"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestRoundTo() {
+	tests := []struct {
+		name     string
+		value    float64
+		decimals int
+		mode     RoundMode
+		want     float64
+	}{
+		{name: "half-up", value: 2.675, decimals: 2, mode: RoundHalfUp, want: 2.68},
+		{name: "half-up отрицательное", value: -2.675, decimals: 2, mode: RoundHalfUp, want: -2.68},
+		{name: "half-even к четному вниз", value: 2.665, decimals: 2, mode: RoundHalfEven, want: 2.66},
+		{name: "half-even к четному вверх", value: 2.675, decimals: 2, mode: RoundHalfEven, want: 2.68},
+		{name: "half-even не половина", value: 2.6651, decimals: 2, mode: RoundHalfEven, want: 2.67},
+		{name: "floor", value: 4.729, decimals: 2, mode: RoundFloor, want: 4.72},
+		{name: "floor точное значение", value: 4.1, decimals: 2, mode: RoundFloor, want: 4.1},
+		{name: "floor отрицательное", value: -4.721, decimals: 2, mode: RoundFloor, want: -4.73},
+		{name: "ceil", value: 4.721, decimals: 2, mode: RoundCeil, want: 4.73},
+		{name: "ceil точное значение", value: 0.3, decimals: 1, mode: RoundCeil, want: 0.3},
+		{name: "без знаков", value: 354.375, decimals: 0, mode: RoundHalfUp, want: 354},
+		{name: "до десятков", value: 354.375, decimals: -1, mode: RoundHalfUp, want: 350},
+		{name: "неизвестный режим - half-up", value: 2.675, decimals: 2, mode: RoundMode(9), want: 2.68},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, RoundTo(tt.value, tt.decimals, tt.mode))
+		})
+	}
+
+	assert.True(suite.T(), math.IsNaN(RoundTo(math.NaN(), 2, RoundHalfUp)))
+	assert.True(suite.T(), math.IsInf(RoundTo(math.Inf(1), 2, RoundFloor), 1))
+}
+
+func (suite *SpentCaloriesTestSuite) TestRoundToTraining() {
+	t, err := ParseTrainingData("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	// сырые значения из TrainingResult округляются по-разному.
+	var result TrainingResult = t
+	assert.Equal(suite.T(), 4.72, RoundTo(result.Distance, 2, RoundFloor))
+	assert.Equal(suite.T(), 4.73, RoundTo(result.Distance, 2, RoundCeil))
+	assert.Equal(suite.T(), 354.38, RoundTo(result.Calories, 2, RoundHalfUp))
+	assert.Equal(suite.T(), 354.37, RoundTo(result.Calories, 2, RoundFloor))
+}
@@ -0,0 +1,122 @@
+package spentcalories
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Scanner построчно читает тренировки из io.Reader, как bufio.Scanner:
+//
+//	s := NewScanner(r, weight, height)
+//	for s.Scan() {
+//		fmt.Print(s.Training())
+//	}
+//	if err := s.Err(); err != nil {
+//		...
+//	}
+//
+// В отличие от ReadTrainings, результаты не накапливаются в памяти, поэтому
+// Scanner подходит для файлов любого размера. Пустые строки и строки,
+// начинающиеся с '#', пропускаются; эти же правила используют ScanLines
+// и ReadTrainings. Строки с ошибками тоже пропускаются и не прерывают чтение:
+// их количество возвращает Skipped, а ошибку последней из них — LastLineErr.
+//
+// Длина одной строки ограничена 64 КиБ, как у bufio.Scanner: на более
+// длинной строке чтение прекращается, а Err возвращает bufio.ErrTooLong.
+type Scanner struct {
+	scanner        *bufio.Scanner
+	weight, height float64
+
+	line     int      // номер последней прочитанной строки.
+	text     string   // последняя значимая строка без пробелов по краям.
+	training Training // последняя успешно разобранная тренировка.
+	lineErr  error    // ошибка последней пропущенной строки.
+	skipped  int      // количество пропущенных строк с ошибками.
+
+	// onLineErr, если задана, вызывается с ошибкой каждой пропущенной строки.
+	onLineErr func(err error)
+}
+
+// NewScanner принимает:
+// r io.Reader — источник записей о тренировках, по одной на строку.
+// weight, height float64 — вес (кг.) и рост (м.) пользователя.
+//
+// Возвращает:
+// *Scanner — сканер, который читает r по мере вызовов Scan.
+func NewScanner(r io.Reader, weight, height float64) *Scanner {
+	return &Scanner{scanner: bufio.NewScanner(r), weight: weight, height: height}
+}
+
+// Scan переходит к следующей успешно разобранной тренировке, которую затем
+// возвращает Training. Возвращает false, когда данные закончились или
+// произошла ошибка чтения, см. Err.
+func (s *Scanner) Scan() bool {
+	for s.nextLine() {
+		t, err := ParseTrainingData(s.text, s.weight, s.height)
+		if err != nil {
+			s.lineErr = fmt.Errorf("line %d: %w", s.line, err)
+			s.skipped++
+
+			if s.onLineErr != nil {
+				s.onLineErr(s.lineErr)
+			}
+
+			continue
+		}
+
+		s.training = t
+		return true
+	}
+
+	s.training = Training{}
+	return false
+}
+
+// nextLine переходит к следующей значимой строке, которую затем можно взять
+// из s.text, пропуская пустые строки и строки, начинающиеся с '#'.
+// Возвращает false, когда данные закончились или произошла ошибка чтения.
+func (s *Scanner) nextLine() bool {
+	for s.scanner.Scan() {
+		s.line++
+
+		s.text = strings.TrimSpace(s.scanner.Text())
+		if s.text == "" || strings.HasPrefix(s.text, "#") {
+			continue
+		}
+
+		return true
+	}
+
+	s.text = ""
+	return false
+}
+
+// Training возвращает тренировку, найденную последним вызовом Scan.
+func (s *Scanner) Training() Training {
+	return s.training
+}
+
+// Line возвращает номер строки, из которой получена текущая тренировка,
+// начиная с единицы.
+func (s *Scanner) Line() int {
+	return s.line
+}
+
+// LastLineErr возвращает ошибку последней пропущенной строки с номером
+// строки или nil, если таких строк не было.
+func (s *Scanner) LastLineErr() error {
+	return s.lineErr
+}
+
+// Skipped возвращает количество строк, пропущенных из-за ошибок.
+func (s *Scanner) Skipped() int {
+	return s.skipped
+}
+
+// Err возвращает ошибку чтения из r. Ошибки разбора строк сюда
+// не попадают, см. LastLineErr.
+func (s *Scanner) Err() error {
+	return s.scanner.Err()
+}
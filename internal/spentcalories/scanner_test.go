@@ -0,0 +1,79 @@
+package spentcalories
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestScanner() {
+	data := "# утро\n6000,Бег,1h00m\n\nsomething is wrong\r\n3000,Ходьба,30m\n6000,Йога,1h00m\n"
+
+	s := NewScanner(strings.NewReader(data), 75.0, 1.75)
+
+	var (
+		activities []string
+		lines      []int
+	)
+	for s.Scan() {
+		activities = append(activities, s.Training().Activity)
+		lines = append(lines, s.Line())
+	}
+
+	assert.NoError(suite.T(), s.Err())
+	assert.Equal(suite.T(), []string{"Бег", "Ходьба"}, activities)
+	assert.Equal(suite.T(), []int{2, 5}, lines)
+	assert.Equal(suite.T(), 2, s.Skipped())
+	assert.ErrorIs(suite.T(), s.LastLineErr(), ErrUnknownActivity)
+	assert.ErrorContains(suite.T(), s.LastLineErr(), "line 6:")
+	assert.Equal(suite.T(), Training{}, s.Training())
+	assert.False(suite.T(), s.Scan())
+}
+
+func (suite *SpentCaloriesTestSuite) TestScannerMatchesReadTrainings() {
+	data := strings.Join(benchRecords(1000), "\n")
+
+	want, _ := ReadTrainings(strings.NewReader(data), 75.0, 1.75)
+
+	var got []Training
+	s := NewScanner(strings.NewReader(data), 75.0, 1.75)
+	for s.Scan() {
+		got = append(got, s.Training())
+	}
+
+	assert.NoError(suite.T(), s.Err())
+	assert.Equal(suite.T(), want, got)
+	assert.Equal(suite.T(), 100, s.Skipped())
+}
+
+func (suite *SpentCaloriesTestSuite) TestScannerReadError() {
+	readErr := errors.New("disk is on fire")
+	s := NewScanner(iotest.ErrReader(readErr), 75.0, 1.75)
+
+	assert.False(suite.T(), s.Scan())
+	assert.ErrorIs(suite.T(), s.Err(), readErr)
+	assert.NoError(suite.T(), s.LastLineErr())
+	assert.Zero(suite.T(), s.Skipped())
+}
+
+func BenchmarkScanner(b *testing.B) {
+	data := strings.Join(benchRecords(1000000), "\n")
+
+	b.Run("scanner", func(b *testing.B) {
+		for b.Loop() {
+			s := NewScanner(strings.NewReader(data), 75.0, 1.75)
+			for s.Scan() {
+				_ = s.Training()
+			}
+		}
+	})
+
+	b.Run("ReadTrainings", func(b *testing.B) {
+		for b.Loop() {
+			_, _ = ReadTrainings(strings.NewReader(data), 75.0, 1.75)
+		}
+	})
+}
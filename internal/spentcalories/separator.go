@@ -0,0 +1,73 @@
+package spentcalories
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// forbiddenSepChars — символы, которые встречаются внутри полей записи:
+// в числах, продолжительностях ("1h30m", "1:30:00") и полях вида ключ=значение.
+const forbiddenSepChars = "0123456789.:=+-hmsunµ"
+
+// validateSep проверяет, что разделителем sep можно однозначно разбить запись.
+func validateSep(sep string) error {
+	if sep == "" {
+		return errors.New("separator is empty")
+	}
+
+	if strings.ContainsAny(sep, forbiddenSepChars) {
+		return fmt.Errorf("separator %q may appear inside a field", sep)
+	}
+
+	return nil
+}
+
+// TrainingInfoSep — то же, что TrainingInfo, но поля записи разделяются
+// строкой sep, например ";" или "\t": "3456;Ходьба;3h00m".
+// Разделитель не может быть пустым и не может содержать символы,
+// которые встречаются внутри полей, например цифры, ':' или 'h'.
+func TrainingInfoSep(data, sep string, weight, height float64) (string, error) {
+	if err := validateSep(sep); err != nil {
+		return "", err
+	}
+
+	t, err := parseTrainingData(data, sep, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	return t.String(), nil
+}
+
+// bom — метка порядка байтов, которую программы под Windows добавляют
+// в начало CSV-файла.
+const bom = "\ufeff"
+
+// recordSep возвращает разделитель полей записи data: запятую или,
+// если запятых нет, точку с запятой ("3456;Ходьба;3h00m").
+func recordSep(data string) string {
+	if !strings.Contains(data, ",") && strings.Contains(data, ";") {
+		return ";"
+	}
+
+	return ","
+}
+
+// SplitRecord разбивает запись data на поля. Поля разделяются запятой
+// или точкой с запятой (см. recordSep), пробелы вокруг полей и метка BOM
+// в начале записи отбрасываются: "\ufeff3456; Ходьба; 3h00m" дает
+// []string{"3456", "Ходьба", "3h00m"}. Количество полей не проверяется.
+func SplitRecord(data string) []string {
+	return splitRecord(data, recordSep(data))
+}
+
+// splitRecord — то же, что SplitRecord, но с заданным разделителем sep.
+func splitRecord(data, sep string) []string {
+	parts := strings.Split(strings.TrimPrefix(data, bom), sep)
+	for i, part := range parts {
+		parts[i] = strings.TrimSpace(part)
+	}
+
+	return parts
+}
@@ -0,0 +1,44 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoSep() {
+	want, err := TrainingInfo("3456,Ходьба,3h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	tests := []struct {
+		name  string
+		input string
+		sep   string
+	}{
+		{name: "запятая", input: "3456,Ходьба,3h00m", sep: ","},
+		{name: "точка с запятой", input: "3456;Ходьба;3h00m", sep: ";"},
+		{name: "табуляция", input: "3456\tХодьба\t3h00m", sep: "\t"},
+		{name: "несколько символов", input: "3456 || Ходьба || 3:00:00", sep: "||"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoSep(tt.input, tt.sep, 75.0, 1.75)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), want, got)
+		})
+	}
+
+	got, err := TrainingInfoSep("3456;Ходьба;3h00m;terrain=snow", ";", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Покрытие: snow (x1.60)\n")
+
+	_, err = TrainingInfoSep("3456,Ходьба,3h00m", ";", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrBadDataFormat)
+
+	_, err = TrainingInfoSep("3456Ходьба3h00m", "", 75.0, 1.75)
+	assert.EqualError(suite.T(), err, "separator is empty")
+
+	for _, sep := range []string{":", "h", "m", ".", "0", "="} {
+		_, err = TrainingInfoSep("3456,Ходьба,3h00m", sep, 75.0, 1.75)
+		assert.ErrorContains(suite.T(), err, "may appear inside a field", sep)
+	}
+}
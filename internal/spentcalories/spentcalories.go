@@ -7,6 +7,7 @@ package spentcalories
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"time"
@@ -14,55 +15,328 @@ import (
 
 // Основные константы, необходимые для расчетов.
 const (
-	lenStep                    = 0.65 // средняя длина шага.
 	mInKm                      = 1000 // количество метров в километре.
 	minInH                     = 60   // количество минут в часе.
 	stepLengthCoefficient      = 0.45 // коэффициент для расчета длины шага на основе роста.
 	walkingCaloriesCoefficient = 0.5  // коэффициент для расчета калорий при ходьбе
+	rowingEfficiency           = 0.25 // КПД человека при гребле.
+	jInKcal                    = 4184 // количество джоулей в килокалории.
+	restingKcalPerKgH          = 1.0  // расход в покое (1 MET), ккал на кг в час.
+	cyclingCaloriesCoefficient = 0.4  // коэффициент для расчета калорий при езде на велосипеде.
+	swimmingSpeedShift         = 1.1  // сдвиг средней скорости при расчете калорий при плавании.
+	swimmingWeightMultiplier   = 2    // множитель веса при расчете калорий при плавании.
+	maxPoolLength              = 100  // максимальная длина бассейна в метрах.
 )
 
-// parseTraining принимает строку с данными формата "3456,Ходьба,3h00m",
-// которая содержит количество шагов, вид активности и продолжительность активности.
+// MaxSteps — максимальное количество шагов в одной записи. Большие значения
+// почти наверняка ошибочны, а при значениях около math.MaxInt расчеты
+// теряют точность. Более строгую границу можно задать через Limits.MaxSteps.
+const MaxSteps = 200000
+
+// checkSteps возвращает ErrTooManySteps, если steps больше MaxSteps.
+func checkSteps(steps int) error {
+	if steps > MaxSteps {
+		return fmt.Errorf("%w: %d > %d", ErrTooManySteps, steps, MaxSteps)
+	}
+
+	return nil
+}
+
+// checkHeight проверяет рост для расчета дистанции по шагам: неположительный
+// рост дает ErrNonPositiveHeight, а рост вне диапазона ValidateHeight,
+// например в сантиметрах, — *HeightError.
+func checkHeight(height float64) error {
+	if height <= 0 {
+		return fmt.Errorf("%w: %v", ErrNonPositiveHeight, height)
+	}
+
+	if _, err := ValidateHeight(height); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// checkCalories возвращает ErrCaloriesOverflow, если calories — бесконечность
+// или NaN, например из-за огромного веса или продолжительности.
+func checkCalories(calories float64) error {
+	if math.IsInf(calories, 0) || math.IsNaN(calories) {
+		return fmt.Errorf("%w: %v", ErrCaloriesOverflow, calories)
+	}
+
+	return nil
+}
+
+// ParseTraining проверяет и разбирает строку формата "3456,Ходьба,3h00m"
+// так же, как TrainingInfo, но без расчета дистанции и калорий. Пригодится,
+// чтобы сразу сообщить пользователю о некорректном вводе. Принимаются те же
+// записи, что и в TrainingInfo: с точкой с запятой, переставленными полями,
+// четвертым полем и полями вида "terrain=snow", см. parseTrainingRecord.
+// Вид активности и допустимость четвертого поля для нее не проверяются:
+// такие ошибки вернет только TrainingInfo.
 //
 // Возвращает:
 // int — количество шагов.
-// string — вид активности.
+// string — вид активности, см. activityName.
 // time.Duration — продолжительность активности.
-// error — ошибку, если что-то пошло не так.
-func parseTraining(data string) (int, string, time.Duration, error) {
-	parts := strings.Split(data, ",")
-	if len(parts) != 3 {
-		return 0, "", 0, errors.New("bad data format")
+// error — ErrBadDataFormat или ErrNonPositive* для некорректной строки.
+func ParseTraining(data string) (int, string, time.Duration, error) {
+	rec, err := parseTrainingRecord(data, recordSep(data))
+	if err != nil {
+		return 0, "", 0, err
+	}
+
+	return rec.steps, rec.name, rec.duration, nil
+}
+
+// orderTrainingFields приводит три обязательных поля записи к порядку
+// "шаги, активность, продолжительность", если они переставлены, например
+// "Ходьба,3456,3h00m". Шагами считается единственное поле с целым числом,
+// продолжительностью — единственное поле, которое разбирает ParseDuration,
+// а активностью — оставшееся. Если порядок уже правильный или поля нельзя
+// однозначно определить, parts возвращается без изменений, чтобы ошибку
+// разбора вернул parseTrainingFields.
+func orderTrainingFields(parts []string) []string {
+	isSteps := func(field string) bool {
+		_, err := strconv.Atoi(strings.TrimSpace(field))
+		return err == nil
 	}
 
-	steps, err := strconv.Atoi(parts[0])
+	// "0" разбирается и как продолжительность, но без единиц измерения
+	// число считается шагами.
+	isDuration := func(field string) bool {
+		_, err := ParseDuration(strings.TrimSpace(field))
+		return err == nil && !isSteps(field)
+	}
+
+	if isSteps(parts[0]) && isDuration(parts[2]) {
+		return parts
+	}
+
+	stepsIdx, durationIdx := -1, -1
+	for i, field := range parts {
+		if isSteps(field) {
+			if stepsIdx >= 0 {
+				return parts
+			}
+			stepsIdx = i
+		}
+
+		if isDuration(field) {
+			if durationIdx >= 0 {
+				return parts
+			}
+			durationIdx = i
+		}
+	}
+
+	if stepsIdx < 0 || durationIdx < 0 || stepsIdx == durationIdx {
+		return parts
+	}
+
+	// индексы трех полей в сумме дают 3, поэтому активность — оставшееся.
+	activityIdx := 3 - stepsIdx - durationIdx
+
+	return []string{parts[stepsIdx], parts[activityIdx], parts[durationIdx]}
+}
+
+// parseTrainingFields разбирает три обязательных поля записи:
+// количество шагов, вид активности и продолжительность — и проверяет их
+// так же, как newTrainingRecord.
+// Пробелы и табуляции вокруг полей отбрасываются: "3456, Ходьба, 3h00m".
+// Если allowZeroSteps равен true, нулевое количество шагов допускается.
+func parseTrainingFields(parts []string, allowZeroSteps bool) (trainingRecord, error) {
+	steps, err := strconv.Atoi(strings.TrimSpace(parts[0]))
 	if err != nil {
-		return 0, "", 0, fmt.Errorf("failed to extract steps: %w", err)
+		return trainingRecord{}, fmt.Errorf("%w: failed to extract steps: %w", ErrBadDataFormat, err)
 	}
 
-	if steps <= 0 {
-		return 0, "", 0, errors.New("steps is not positive")
+	d, err := ParseDuration(strings.TrimSpace(parts[2]))
+	if err != nil {
+		return trainingRecord{}, fmt.Errorf("%w: failed to extract duration: %w", ErrBadDataFormat, err)
+	}
+
+	return newTrainingRecord(steps, parts[1], d, allowZeroSteps)
+}
+
+// checkTrainingSteps проверяет количество шагов steps для активности activity:
+// оно должно быть положительным и не больше MaxSteps.
+// Если allowZeroSteps равен true, нулевое количество шагов допускается.
+func checkTrainingSteps(steps int, activity string, allowZeroSteps bool) error {
+	if steps < 0 || (steps == 0 && !allowZeroSteps) {
+		return ErrNonPositiveSteps
+	}
+
+	// для велосипеда первое поле может быть дистанцией в метрах, а не шагами.
+	if isCycling(canonicalActivity(activity)) {
+		return nil
+	}
+
+	return checkSteps(steps)
+}
+
+// trainingRecord — запись о тренировке вместе с необязательными полями.
+type trainingRecord struct {
+	steps    int
+	activity string // вид активности в каноническом написании.
+	name     string // название активности для вывода, см. activityName.
+	duration time.Duration
+	extra    float64 // числовое четвертое поле, 0 — не указано.
+	terrain  string  // тип покрытия, пустая строка — ровная дорога.
+	age      int     // возраст для расчета по пульсу, 0 — referenceAge.
+	sex      Sex     // пол для расчета по пульсу.
+}
+
+// newTrainingRecord проверяет обязательные поля записи — количество шагов
+// и продолжительность — и собирает из них trainingRecord без необязательных
+// полей. Вид активности приводится к каноническому написанию, см. canonicalActivity.
+// Если allowZeroSteps равен true, нулевое количество шагов допускается.
+func newTrainingRecord(steps int, activity string, duration time.Duration, allowZeroSteps bool) (trainingRecord, error) {
+	if err := checkTrainingSteps(steps, activity, allowZeroSteps); err != nil {
+		return trainingRecord{}, err
+	}
+
+	if duration <= 0 {
+		return trainingRecord{}, ErrNonPositiveDuration
+	}
+
+	activity = strings.TrimSpace(activity)
+
+	return trainingRecord{
+		steps:    steps,
+		activity: canonicalActivity(activity),
+		name:     activityName(activity),
+		duration: duration,
+	}, nil
+}
+
+// parseTrainingRecord разбирает строку формата "3456,Ходьба,3h00m".
+// Вид активности и его псевдонимы приводятся к каноническому написанию,
+// см. canonicalActivity.
+// Четвертым полем может идти число, смысл которого зависит от активности:
+// дистанция в километрах для велосипеда ("0,Велосипед,1h30m,25.4"),
+// длина бассейна в метрах для плавания ("40,Плавание,45m,50")
+// или средний пульс для бега и ходьбы ("5600,Бег,40m,156").
+// За ним могут идти поля вида ключ=значение ("3456,Ходьба,3h00m,terrain=snow").
+// Если для велосипеда указана дистанция, количество шагов может быть нулевым.
+// Поля разделяются строкой sep, обычно запятой, BOM в начале записи и пробелы
+// вокруг полей отбрасываются, см. SplitRecord.
+func parseTrainingRecord(data, sep string) (trainingRecord, error) {
+	parts := splitRecord(data, sep)
+	if len(parts) < 3 {
+		return trainingRecord{}, ErrBadDataFormat
+	}
+
+	var (
+		extra   float64
+		terrain string
+	)
+
+	for i, field := range parts[3:] {
+		key, value, ok := strings.Cut(field, "=")
+		if !ok {
+			if i != 0 {
+				return trainingRecord{}, fmt.Errorf("%w: unexpected field %q", ErrBadDataFormat, field)
+			}
+
+			n, err := strconv.ParseFloat(field, 64)
+			if err != nil {
+				return trainingRecord{}, fmt.Errorf("%w: failed to extract fourth field: %w", ErrBadDataFormat, err)
+			}
+
+			if n <= 0 {
+				return trainingRecord{}, fmt.Errorf("%w: fourth field is not positive", ErrBadDataFormat)
+			}
+
+			extra = n
+			continue
+		}
+
+		switch key {
+		case "terrain":
+			terrain = value
+		default:
+			return trainingRecord{}, fmt.Errorf("%w: unknown field %q", ErrBadDataFormat, key)
+		}
 	}
 
-	activity := parts[1]
+	fields := orderTrainingFields(parts[:3])
+	allowZeroSteps := extra > 0 && isCycling(canonicalActivity(fields[1]))
 
-	d, err := time.ParseDuration(parts[2])
+	rec, err := parseTrainingFields(fields, allowZeroSteps)
 	if err != nil {
-		return 0, "", 0, fmt.Errorf("failed to extract duration: %w", err)
+		return trainingRecord{}, err
 	}
 
-	if d <= 0 {
-		return 0, "", 0, errors.New("duration is not positive")
+	rec.extra, rec.terrain = extra, terrain
+
+	return rec, nil
+}
+
+// StepDistance принимает:
+// steps int — количество шагов.
+// height Metres — рост пользователя.
+//
+// Дистанция считается так же, как в отчете TrainingInfo: шаги умножаются
+// на длину шага StepLength(height).
+//
+// Возвращает:
+// Km — дистанцию в километрах.
+// error — ErrNonPositiveSteps или ErrNonPositiveHeight для некорректных данных.
+func StepDistance(steps int, height Metres) (Km, error) {
+	if steps <= 0 {
+		return 0.0, fmt.Errorf("%w: %d", ErrNonPositiveSteps, steps)
 	}
 
-	return steps, activity, d, nil
+	if height <= 0 {
+		return 0.0, ErrNonPositiveHeight
+	}
+
+	return Km(DistanceWithStep(steps, float64(height), 0)), nil
+}
+
+// Distance — то же, что StepDistance, но рост передается в метрах
+// как float64, а дистанция возвращается в километрах как float64.
+func Distance(steps int, height float64) (float64, error) {
+	dist, err := StepDistance(steps, Metres(height))
+	return float64(dist), err
 }
 
-// distance принимает количество шагов и рост пользователя в метрах,
-// а возвращает дистанцию в километрах.
+// distance — то же, что Distance, но для некорректных данных возвращает 0.
 func distance(steps int, height float64) float64 {
-	length := height * stepLengthCoefficient
-	return float64(steps) * length / mInKm
+	dist, err := Distance(steps, height)
+	if err != nil {
+		return 0.0
+	}
+
+	return dist
+}
+
+// StepLength возвращает длину шага в метрах, рассчитанную по росту height.
+func StepLength(height float64) float64 {
+	return height * stepLengthCoefficient
+}
+
+// StepsFromDistance — обратная к distance функция: возвращает количество шагов,
+// за которое человек ростом height (м.) проходит distanceKm километров,
+// округленное до целого. Если дистанция или рост не больше нуля, возвращается 0.
+func StepsFromDistance(distanceKm float64, height float64) int {
+	if distanceKm <= 0 || height <= 0 {
+		return 0
+	}
+
+	return int(math.Round(distanceKm * mInKm / StepLength(height)))
+}
+
+// DistanceWithStep возвращает дистанцию в километрах для steps шагов
+// длиной stepLen метров. Если stepLen не больше нуля, длина шага
+// рассчитывается по росту height, как в StepLength.
+func DistanceWithStep(steps int, height, stepLen float64) float64 {
+	if stepLen <= 0 {
+		stepLen = StepLength(height)
+	}
+
+	return float64(steps) * stepLen / mInKm
 }
 
 // meanSpeed принимает количество шагов steps,
@@ -77,47 +351,317 @@ func meanSpeed(steps int, height float64, duration time.Duration) float64 {
 	return dist / duration.Hours()
 }
 
-// TrainingInfo принимает:
-// data string — строку с данными формата "3456,Ходьба,3h00m".
+// isCycling сообщает, относится ли вид активности к езде на велосипеде.
+func isCycling(activity string) bool {
+	return activity == "Велоспорт" || activity == "Велосипед"
+}
+
+// MeanPace принимает количество шагов steps, рост пользователя height
+// и продолжительность активности duration и возвращает средний темп —
+// время на один километр. Если дистанция или продолжительность не больше нуля,
+// возвращается 0.
+func MeanPace(steps int, height float64, duration time.Duration) time.Duration {
+	return pace(distance(steps, height), duration)
+}
+
+// Cadence возвращает каденс — количество шагов в минуту.
+// Если продолжительность не больше нуля, возвращается 0.
+func Cadence(steps int, duration time.Duration) float64 {
+	if duration <= 0 {
+		return 0
+	}
+
+	return float64(steps) / duration.Minutes()
+}
+
+// Pace возвращает темп — время на один километр для дистанции distanceKm,
+// пройденной за duration. В отличие от MeanPace, здесь не нужны шаги и рост.
+func Pace(distanceKm float64, duration time.Duration) (time.Duration, error) {
+	if distanceKm <= 0 {
+		return 0, ErrNonPositiveDistance
+	}
+
+	if duration <= 0 {
+		return 0, ErrNonPositiveDuration
+	}
+
+	return pace(distanceKm, duration), nil
+}
+
+// pace возвращает время на один километр для дистанции dist в километрах,
+// пройденной за duration, или 0, если дистанция или продолжительность не больше нуля.
+func pace(dist float64, duration time.Duration) time.Duration {
+	if dist <= 0 || duration <= 0 {
+		return 0
+	}
+
+	return time.Duration(float64(duration) / dist)
+}
+
+// ParseTrainingData принимает:
+// data string — строку с данными формата "3456,Ходьба,3h00m" или "3456;Ходьба;3h00m".
 // weight, height float64 — вес (кг.) и рост (м.) пользователя.
 //
+// Для бега, ходьбы и других активностей с шагами рост проверяется до расчета:
+// для роста не больше нуля возвращается ErrNonPositiveHeight, для роста
+// вне 0.9–2.5 м, например в сантиметрах, — *HeightError. Для велосипеда
+// и плавания рост не нужен и не проверяется.
+//
 // Возвращает:
-// string — строка с информацией о тренировке в формате, приведенном ниже.
+// Training — данные о тренировке без округления.
 // error — ошибку, при ее возникновении внутри функции.
-func TrainingInfo(data string, weight, height float64) (string, error) {
-	steps, activity, d, err := parseTraining(data)
+func ParseTrainingData(data string, weight, height float64) (Training, error) {
+	return parseTrainingData(data, recordSep(data), weight, height)
+}
+
+// parseTrainingData — то же, что ParseTrainingData, но поля записи
+// разделяются строкой sep.
+func parseTrainingData(data, sep string, weight, height float64) (Training, error) {
+	rec, err := parseTrainingRecord(data, sep)
 	if err != nil {
-		return "", fmt.Errorf("parseTraining: %w", err)
+		return Training{}, fmt.Errorf("parseTraining: %w", err)
 	}
 
-	var calories float64 = 0.0
+	return rec.training(weight, height)
+}
+
+// training рассчитывает данные о тренировке по разобранной записи rec
+// для пользователя весом weight (кг.) и ростом height (м.).
+func (rec trainingRecord) training(weight, height float64) (Training, error) {
+	var err error
 
-	switch activity {
-	case "Бег":
-		calories, err = RunningSpentCalories(steps, weight, height, d)
+	steps, activity, d := rec.steps, rec.activity, rec.duration
+
+	if rec.terrain != "" && activity != "Ходьба" {
+		return Training{}, errors.New("terrain is supported for walking only")
+	}
+
+	// для бега и ходьбы четвертое поле — средний пульс.
+	heartRate := 0
+	if rec.extra > 0 && (activity == "Бег" || activity == "Ходьба") {
+		if rec.terrain != "" {
+			return Training{}, errors.New("terrain and heart rate cannot be combined")
+		}
+
+		heartRate = int(math.Round(rec.extra))
+	}
+
+	t := Training{
+		Steps:    steps,
+		Activity: rec.name,
+		Duration: d,
+		Distance: distance(steps, height),
+		Speed:    meanSpeed(steps, height, d),
+		Terrain:  rec.terrain,
+	}
+
+	var calories Kcal = 0.0
+
+	// дистанция и скорость по шагам при некорректном росте не имеют смысла,
+	// поэтому в ветках с шагами рост проверяется до расчета.
+	// Велосипеду и плаванию рост не нужен.
+	switch {
+	case heartRate > 0:
+		if err := checkHeight(height); err != nil {
+			return Training{}, err
+		}
+
+		age := rec.age
+		if age == 0 {
+			age = referenceAge
+		}
+
+		hrCalories, err := SpentCaloriesHR(heartRate, weight, age, rec.sex, d)
 		if err != nil {
-			return "", fmt.Errorf("RunningSpentCalories: %w", err)
+			return Training{}, fmt.Errorf("SpentCaloriesHR: %w", err)
 		}
-	case "Ходьба":
-		calories, err = WalkingSpentCalories(steps, weight, height, d)
+
+		t.HeartRate = heartRate
+		calories = Kcal(hrCalories)
+	case activity == "Бег":
+		if err := checkHeight(height); err != nil {
+			return Training{}, err
+		}
+
+		calories, err = RunningCalories(steps, Kilograms(weight), Metres(height), d)
+		if err != nil {
+			return Training{}, fmt.Errorf("RunningCalories: %w", err)
+		}
+	case activity == "Ходьба":
+		if err := checkHeight(height); err != nil {
+			return Training{}, err
+		}
+
+		calories, err = WalkingCalories(steps, Kilograms(weight), Metres(height), d)
 		if err != nil {
-			return "", fmt.Errorf("WalkingSpentCalories: %w", err)
+			return Training{}, fmt.Errorf("WalkingCalories: %w", err)
 		}
+	case isCycling(activity):
+		// для велосипеда дистанция берется из четвертого поля в километрах,
+		// а если его нет — из первого поля в метрах.
+		t.Steps = 0
+		t.Distance = rec.extra
+		if t.Distance == 0 {
+			t.Distance = float64(steps) / mInKm
+		}
+		t.Speed = t.Distance / d.Hours()
+
+		cyclingCalories, err := CyclingSpentCalories(t.Distance, weight, d)
+		if err != nil {
+			return Training{}, fmt.Errorf("CyclingSpentCalories: %w", err)
+		}
+
+		calories = Kcal(cyclingCalories)
+	case activity == "Плавание":
+		// для плавания первое поле — количество бассейнов, четвертое — длина бассейна.
+		swimmingCalories, err := SwimmingSpentCalories(steps, rec.extra, weight, d)
+		if err != nil {
+			return Training{}, fmt.Errorf("SwimmingSpentCalories: %w", err)
+		}
+
+		t.Steps = 0
+		t.Distance = float64(steps) * rec.extra / mInKm
+		t.Speed = t.Distance / d.Hours()
+		calories = Kcal(swimmingCalories)
 	default:
-		return "", errors.New("неизвестный тип тренировки")
+		fn, ok := customCalories(activity)
+		if !ok {
+			return Training{}, fmt.Errorf("%w: %q", ErrUnknownActivity, rec.name)
+		}
+
+		if rec.extra > 0 {
+			return Training{}, fmt.Errorf("%w: fourth field is not supported for %q", ErrBadDataFormat, rec.name)
+		}
+
+		if err := checkHeight(height); err != nil {
+			return Training{}, err
+		}
+
+		activityCalories, err := fn(steps, weight, height, d)
+		if err != nil {
+			return Training{}, fmt.Errorf("%s: %w", rec.name, err)
+		}
+
+		calories = Kcal(activityCalories)
 	}
 
-	text := `Тип тренировки: %s
-Длительность: %.2f ч.
-Дистанция: %.2f км.
-Скорость: %.2f км/ч
-Сожгли калорий: %.2f
-`
+	if rec.terrain != "" {
+		multiplier, err := TerrainMultiplier(rec.terrain)
+		if err != nil {
+			return Training{}, err
+		}
 
-	speed := meanSpeed(steps, height, d)
-	dist := distance(steps, height)
+		calories *= Kcal(multiplier)
+	}
 
-	return fmt.Sprintf(text, activity, d.Hours(), dist, speed, calories), nil
+	if err := checkCalories(float64(calories)); err != nil {
+		return Training{}, err
+	}
+
+	t.Calories = float64(calories)
+	t.Pace = pace(t.Distance, d)
+	t.Cadence = Cadence(t.Steps, d)
+
+	return t, nil
+}
+
+// TrainingInfoResult — то же, что ParseTrainingData: возвращает данные
+// о тренировке в виде структуры, чтобы их не нужно было разбирать из строки,
+// например для своего интерфейса или сериализации.
+func TrainingInfoResult(data string, weight, height float64) (TrainingResult, error) {
+	return ParseTrainingData(data, weight, height)
+}
+
+// TrainingStats — другое имя TrainingInfoResult. Продолжительность в часах,
+// дистанцию и скорость можно получить методами DurationHours, DistanceKm
+// и SpeedKmh.
+func TrainingStats(data string, weight, height float64) (TrainingResult, error) {
+	return TrainingInfoResult(data, weight, height)
+}
+
+// TrainingInfo принимает:
+// data string — строку с данными формата "3456,Ходьба,3h00m".
+// weight, height float64 — вес (кг.) и рост (м.) пользователя.
+//
+// Возвращает:
+// string — строка с информацией о тренировке в формате Training.String.
+// error — ошибку, при ее возникновении внутри функции.
+//
+// Если поля уже разобраны, используйте TrainingInfoFields.
+func TrainingInfo(data string, weight, height float64) (string, error) {
+	rec, err := parseTrainingRecord(data, recordSep(data))
+	if err != nil {
+		return "", fmt.Errorf("parseTraining: %w", err)
+	}
+
+	return rec.info(weight, height)
+}
+
+// TrainingInfoFields — то же, что TrainingInfo, но принимает уже разобранные
+// поля записи, например из ParseTraining или из формы ввода, без сборки
+// строки "3456,Ходьба,3h00m". Проверки и расчет совпадают с TrainingInfo
+// для записи из трех полей.
+//
+// Возвращает:
+// string — строка с информацией о тренировке в формате Training.String.
+// error — ошибку, при ее возникновении внутри функции.
+func TrainingInfoFields(steps int, activity string, duration time.Duration, weight, height float64) (string, error) {
+	rec, err := newTrainingRecord(steps, activity, duration, false)
+	if err != nil {
+		return "", err
+	}
+
+	return rec.info(weight, height)
+}
+
+// info рассчитывает тренировку по записи rec и возвращает отчет в формате
+// Training.String. Через нее проходят и TrainingInfo, и TrainingInfoFields.
+func (rec trainingRecord) info(weight, height float64) (string, error) {
+	t, err := rec.training(weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	return t.String(), nil
+}
+
+// RunningCalories принимает:
+// steps int — количество шагов.
+// weight Kilograms, height Metres — вес и рост пользователя.
+// duration time.Duration — продолжительность бега.
+//
+// Возвращает:
+// Kcal — количество калорий, потраченных при беге.
+// error — ошибку, если входные параметры некорректны.
+func RunningCalories(steps int, weight Kilograms, height Metres, duration time.Duration) (Kcal, error) {
+	if steps <= 0 {
+		return 0, fmt.Errorf("%w: %d", ErrNonPositiveSteps, steps)
+	}
+
+	if err := checkSteps(steps); err != nil {
+		return 0.0, err
+	}
+
+	if weight <= 0 {
+		return 0.0, ErrNonPositiveWeight
+	}
+
+	if height <= 0 {
+		return 0.0, ErrNonPositiveHeight
+	}
+
+	if duration <= 0 {
+		return 0.0, ErrNonPositiveDuration
+	}
+
+	ms := meanSpeed(steps, float64(height), duration)
+	calories := (float64(weight) * ms * duration.Minutes()) / minInH
+
+	if err := checkCalories(calories); err != nil {
+		return 0.0, err
+	}
+
+	return Kcal(calories), nil
 }
 
 // RunningSpentCalories принимает:
@@ -128,22 +672,47 @@ func TrainingInfo(data string, weight, height float64) (string, error) {
 // Возвращает:
 // float64 — количество калорий, потраченных при беге.
 // error — ошибку, если входные параметры некорректны.
+//
+// Deprecated: используйте RunningCalories, где вес и рост нельзя перепутать.
 func RunningSpentCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
+	calories, err := RunningCalories(steps, Kilograms(weight), Metres(height), duration)
+	return float64(calories), err
+}
+
+// WalkingCalories принимает:
+// steps int — количество шагов.
+// weight Kilograms, height Metres — вес и рост пользователя.
+// duration time.Duration — продолжительность ходьбы.
+//
+// Возвращает:
+// Kcal — количество калорий, потраченных при ходьбе.
+// error — ошибку, если входные параметры некорректны.
+func WalkingCalories(steps int, weight Kilograms, height Metres, duration time.Duration) (Kcal, error) {
 	if steps <= 0 {
-		return 0, fmt.Errorf("incorrect steps count: %d", steps)
+		return 0.0, ErrNonPositiveSteps
+	}
+
+	if err := checkSteps(steps); err != nil {
+		return 0.0, err
 	}
 
 	if weight <= 0 {
-		return 0.0, errors.New("weight is not positive")
+		return 0.0, ErrNonPositiveWeight
 	}
 
-	if duration <= 0 {
-		return 0.0, errors.New("duration is not positive")
+	if height <= 0 {
+		return 0.0, ErrNonPositiveHeight
 	}
 
-	ms := meanSpeed(steps, height, duration)
+	ms := meanSpeed(steps, float64(height), duration)
+	calories := float64(weight) * ms * duration.Minutes()
+	caloriesSpent := calories / minInH * walkingCaloriesCoefficient
+
+	if err := checkCalories(caloriesSpent); err != nil {
+		return 0.0, err
+	}
 
-	return (weight * ms * duration.Minutes()) / minInH, nil
+	return Kcal(caloriesSpent), nil
 }
 
 // WalkingSpentCalories принимает:
@@ -154,22 +723,215 @@ func RunningSpentCalories(steps int, weight, height float64, duration time.Durat
 // Возвращает:
 // float64 — количество калорий, потраченных при ходьбе.
 // error — ошибку, если входные параметры некорректны.
+//
+// Deprecated: используйте WalkingCalories, где вес и рост нельзя перепутать.
 func WalkingSpentCalories(steps int, weight, height float64, duration time.Duration) (float64, error) {
-	if steps <= 0 {
-		return 0.0, errors.New("steps is not positive")
+	calories, err := WalkingCalories(steps, Kilograms(weight), Metres(height), duration)
+	return float64(calories), err
+}
+
+// CyclingSpentCalories принимает:
+// distanceKm float64 — дистанция заезда (км.).
+// weight float64 — вес пользователя (кг.).
+// duration time.Duration — продолжительность заезда.
+//
+// На велосипеде нет шагов, поэтому скорость считается напрямую по дистанции.
+//
+// Возвращает:
+// float64 — количество калорий, потраченных при езде на велосипеде.
+// error — ошибку, если входные параметры некорректны.
+func CyclingSpentCalories(distanceKm, weight float64, duration time.Duration) (float64, error) {
+	if distanceKm <= 0 {
+		return 0.0, ErrNonPositiveDistance
 	}
 
 	if weight <= 0 {
-		return 0.0, errors.New("weight is not positive")
+		return 0.0, ErrNonPositiveWeight
 	}
 
-	if height <= 0 {
-		return 0.0, errors.New("height is not positive")
+	if duration <= 0 {
+		return 0.0, ErrNonPositiveDuration
+	}
+
+	speed := distanceKm / duration.Hours()
+	calories := weight * speed * duration.Minutes() / minInH
+
+	return calories * cyclingCaloriesCoefficient, nil
+}
+
+// SwimmingSpentCalories принимает:
+// lengths int — количество проплытых бассейнов.
+// poolLengthMeters float64 — длина бассейна (м.), от 0 до 100.
+// weight float64 — вес пользователя (кг.).
+// duration time.Duration — продолжительность плавания.
+//
+// Возвращает:
+// float64 — количество калорий, потраченных при плавании.
+// error — ошибку, если входные параметры некорректны.
+func SwimmingSpentCalories(lengths int, poolLengthMeters, weight float64, duration time.Duration) (float64, error) {
+	if lengths <= 0 {
+		return 0.0, errors.New("lengths is not positive")
+	}
+
+	if poolLengthMeters <= 0 {
+		return 0.0, errors.New("pool length is not positive")
+	}
+
+	if poolLengthMeters > maxPoolLength {
+		return 0.0, fmt.Errorf("pool length is too large: %v m", poolLengthMeters)
+	}
+
+	return SwimmingSpentCaloriesByDistance(float64(lengths)*poolLengthMeters, weight, duration)
+}
+
+// SwimmingSpentCaloriesByDistance — то же, что SwimmingSpentCalories, но вместо
+// количества и длины бассейнов передается дистанция distanceMeters в метрах,
+// например для открытой воды. Калории считаются по средней скорости,
+// без шагов и роста:
+//
+//	(скорость(км/ч) + 1.1) * 2 * вес(кг) * часы
+func SwimmingSpentCaloriesByDistance(distanceMeters, weight float64, duration time.Duration) (float64, error) {
+	if distanceMeters <= 0 {
+		return 0.0, ErrNonPositiveDistance
+	}
+
+	if weight <= 0 {
+		return 0.0, ErrNonPositiveWeight
+	}
+
+	if duration <= 0 {
+		return 0.0, ErrNonPositiveDuration
 	}
 
-	ms := meanSpeed(steps, height, duration)
-	calories := weight * ms * duration.Minutes()
-	caloriesSpent := calories / minInH
+	speed := distanceMeters / mInKm / duration.Hours()
+
+	return (speed + swimmingSpeedShift) * swimmingWeightMultiplier * weight * duration.Hours(), nil
+}
+
+// CaloriesFromSpeed принимает:
+// speedKmh float64 — средняя скорость (км/ч).
+// weight float64 — вес пользователя (кг.).
+// duration time.Duration — продолжительность активности.
+// activity string — вид активности ("Бег" или "Ходьба").
+//
+// Используется, когда известны только скорость и время, без шагов и дистанции.
+//
+// Возвращает:
+// float64 — количество потраченных калорий.
+// error — ошибку, если входные параметры некорректны.
+func CaloriesFromSpeed(speedKmh, weight float64, duration time.Duration, activity string) (float64, error) {
+	if speedKmh <= 0 {
+		return 0.0, errors.New("speed is not positive")
+	}
+
+	if weight <= 0 {
+		return 0.0, ErrNonPositiveWeight
+	}
+
+	if duration <= 0 {
+		return 0.0, ErrNonPositiveDuration
+	}
+
+	calories := (weight * speedKmh * duration.Minutes()) / minInH
+
+	switch canonicalActivity(activity) {
+	case "Бег":
+		return calories, nil
+	case "Ходьба":
+		return calories * walkingCaloriesCoefficient, nil
+	default:
+		return 0.0, fmt.Errorf("%w: %q", ErrUnknownActivity, activityName(activity))
+	}
+}
+
+// RunningSpentCaloriesByDistance — то же, что RunningSpentCalories, но вместо
+// шагов и роста передается дистанция distanceKm в километрах, например с GPS.
+// Скорость считается напрямую по дистанции, поэтому рост не нужен.
+func RunningSpentCaloriesByDistance(distanceKm, weight float64, duration time.Duration) (float64, error) {
+	return caloriesByDistance(distanceKm, weight, duration, "Бег")
+}
+
+// WalkingSpentCaloriesByDistance — то же, что WalkingSpentCalories, но вместо
+// шагов и роста передается дистанция distanceKm в километрах, например с GPS.
+// Скорость считается напрямую по дистанции, поэтому рост не нужен.
+func WalkingSpentCaloriesByDistance(distanceKm, weight float64, duration time.Duration) (float64, error) {
+	return caloriesByDistance(distanceKm, weight, duration, "Ходьба")
+}
+
+// caloriesByDistance проверяет дистанцию и продолжительность и считает калории
+// через CaloriesFromSpeed по средней скорости на дистанции distanceKm.
+func caloriesByDistance(distanceKm, weight float64, duration time.Duration, activity string) (float64, error) {
+	if distanceKm <= 0 {
+		return 0.0, ErrNonPositiveDistance
+	}
+
+	if duration <= 0 {
+		return 0.0, ErrNonPositiveDuration
+	}
+
+	return CaloriesFromSpeed(distanceKm/duration.Hours(), weight, duration, activity)
+}
+
+// RowingCaloriesFromPower принимает:
+// avgWatts float64 — средняя мощность на гребном тренажёре (Вт).
+// weight float64 — вес пользователя (кг.).
+// duration time.Duration — продолжительность тренировки.
+//
+// Механическая работа делится на КПД гребли (около 25%), к ней добавляется
+// расход в покое за время тренировки. Это та же модель, что использует Concept2.
+//
+// Возвращает:
+// float64 — количество потраченных калорий.
+// error — ошибку, если входные параметры некорректны.
+func RowingCaloriesFromPower(avgWatts, weight float64, duration time.Duration) (float64, error) {
+	if avgWatts <= 0 {
+		return 0.0, errors.New("power is not positive")
+	}
+
+	if weight <= 0 {
+		return 0.0, ErrNonPositiveWeight
+	}
+
+	if duration <= 0 {
+		return 0.0, ErrNonPositiveDuration
+	}
+
+	work := avgWatts * duration.Seconds() / rowingEfficiency
+	resting := restingKcalPerKgH * weight * duration.Hours()
+
+	return work/jInKcal + resting, nil
+}
+
+// CaloriesByMET принимает:
+// met float64 — метаболический эквивалент нагрузки (например, 8.0 для бега, 3.5 для ходьбы).
+// weight float64 — вес пользователя (кг.).
+// duration time.Duration — продолжительность тренировки.
+//
+// Калории считаются как met * weight * часы — модель, по которой составлены
+// стандартные таблицы MET. Её удобно сравнивать с оценками RunningCalories
+// и WalkingCalories.
+//
+// Возвращает:
+// float64 — количество потраченных калорий.
+// error — ошибку, если входные параметры некорректны.
+func CaloriesByMET(met, weight float64, duration time.Duration) (float64, error) {
+	if met <= 0 {
+		return 0.0, errors.New("met is not positive")
+	}
+
+	if weight <= 0 {
+		return 0.0, ErrNonPositiveWeight
+	}
+
+	if duration <= 0 {
+		return 0.0, ErrNonPositiveDuration
+	}
+
+	calories := met * weight * duration.Hours()
+
+	if err := checkCalories(calories); err != nil {
+		return 0.0, err
+	}
 
-	return caloriesSpent * walkingCaloriesCoefficient, nil
+	return calories, nil
 }
@@ -182,7 +182,7 @@ func (suite *SpentCaloriesTestSuite) TestParseTraining() {
 
 	for _, tt := range tests {
 		suite.Run(tt.name, func() {
-			gotSteps, _, gotDuration, err := parseTraining(tt.input)
+			gotSteps, _, gotDuration, err := ParseTraining(tt.input)
 
 			if tt.wantErr {
 				assert.Error(suite.T(), err)
@@ -239,6 +239,41 @@ func (suite *SpentCaloriesTestSuite) TestDistance() {
 	}
 }
 
+func (suite *SpentCaloriesTestSuite) TestDistanceExported() {
+	got, err := Distance(6000, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), distance(6000, 1.75), got)
+
+	t, err := ParseTrainingData("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), t.Distance, got)
+
+	info, err := TrainingInfo("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 4.725, got, 1e-9)
+	assert.Contains(suite.T(), info, "Дистанция: 4.72 км.\n")
+
+	_, err = Distance(0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+
+	_, err = Distance(-5, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+
+	_, err = Distance(6000, 0)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveHeight)
+
+	km, err := StepDistance(6000, Metres(1.75))
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), Km(got), km)
+
+	km, err = StepDistance(6000, FromCentimetres(175))
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 4.725, float64(km), 1e-9)
+
+	_, err = StepDistance(0, Metres(1.75))
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+}
+
 func (suite *SpentCaloriesTestSuite) TestMeanSpeed() {
 	tests := []struct {
 		name      string
@@ -581,7 +616,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "6000,Ходьба,1h00m",
 			weight:  75.0,
 			height:  1.75,
-			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 177.19\n",
+			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 177.19\n",
 			wantErr: false,
 		},
 		{
@@ -589,7 +624,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "6000,Бег,1h00m",
 			weight:  75.0,
 			height:  1.75,
-			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 354.38\n",
+			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 354.38\n",
 			wantErr: false,
 		},
 		{
@@ -597,7 +632,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "20000,Ходьба,1h00m",
 			weight:  75.0,
 			height:  1.75,
-			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 15.75 км.\nСкорость: 15.75 км/ч\nСожгли калорий: 590.62\n",
+			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 15.75 км.\nСкорость: 15.75 км/ч\nТемп: 3:49 мин/км\nКаденс: 333.33 шаг/мин\nСожгли калорий: 590.62\n",
 			wantErr: false,
 		},
 		{
@@ -605,7 +640,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "20000,Бег,1h00m",
 			weight:  75.0,
 			height:  1.75,
-			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 15.75 км.\nСкорость: 15.75 км/ч\nСожгли калорий: 1181.25\n",
+			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 15.75 км.\nСкорость: 15.75 км/ч\nТемп: 3:49 мин/км\nКаденс: 333.33 шаг/мин\nСожгли калорий: 1181.25\n",
 			wantErr: false,
 		},
 		{
@@ -613,7 +648,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "6000,Ходьба,1h00m",
 			weight:  60.0,
 			height:  1.85,
-			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 5.00 км.\nСкорость: 5.00 км/ч\nСожгли калорий: 149.85\n",
+			want:    "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 5.00 км.\nСкорость: 5.00 км/ч\nТемп: 12:01 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 149.85\n",
 			wantErr: false,
 		},
 		{
@@ -621,7 +656,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "6000,Бег,1h00m",
 			weight:  60.0,
 			height:  1.75,
-			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 283.50\n",
+			want:    "Тип тренировки: Бег\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 283.50\n",
 			wantErr: false,
 		},
 		{
@@ -629,7 +664,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "3000,Ходьба,30m",
 			weight:  75.0,
 			height:  1.75,
-			want:    "Тип тренировки: Ходьба\nДлительность: 0.50 ч.\nДистанция: 2.36 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 88.59\n",
+			want:    "Тип тренировки: Ходьба\nДлительность: 0.50 ч.\nДистанция: 2.36 км.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 88.59\n",
 			wantErr: false,
 		},
 		{
@@ -637,12 +672,12 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 			input:   "3000,Бег,30m",
 			weight:  75.0,
 			height:  1.75,
-			want:    "Тип тренировки: Бег\nДлительность: 0.50 ч.\nДистанция: 2.36 км.\nСкорость: 4.72 км/ч\nСожгли калорий: 177.19\n",
+			want:    "Тип тренировки: Бег\nДлительность: 0.50 ч.\nДистанция: 2.36 км.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 177.19\n",
 			wantErr: false,
 		},
 		{
 			name:    "неизвестный тип тренировки",
-			input:   "6000,Плавание,1h00m",
+			input:   "6000,Йога,1h00m",
 			weight:  75.0,
 			height:  1.75,
 			want:    "",
@@ -650,7 +685,7 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 		},
 		{
 			name:    "неизвестный тип тренировки - проверка текста ошибки",
-			input:   "6000,Плавание,1h00m",
+			input:   "6000,Йога,1h00m",
 			weight:  75.0,
 			height:  1.75,
 			want:    "",
@@ -700,3 +735,723 @@ func (suite *SpentCaloriesTestSuite) TestTrainingInfo() {
 		})
 	}
 }
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesFromSpeed() {
+	tests := []struct {
+		name     string
+		speed    float64
+		weight   float64
+		duration time.Duration
+		activity string
+		wantCal  float64
+		wantErr  bool
+	}{
+		{
+			name:     "бег - совпадает с расчётом по шагам",
+			speed:    4.725,
+			weight:   75.0,
+			duration: 1 * time.Hour,
+			activity: "Бег",
+			wantCal:  354.375,
+		},
+		{
+			name:     "ходьба - совпадает с расчётом по шагам",
+			speed:    4.725,
+			weight:   75.0,
+			duration: 1 * time.Hour,
+			activity: "Ходьба",
+			wantCal:  177.1875,
+		},
+		{
+			name:     "нулевая скорость",
+			speed:    0,
+			weight:   75.0,
+			duration: 1 * time.Hour,
+			activity: "Бег",
+			wantErr:  true,
+		},
+		{
+			name:     "отрицательная скорость",
+			speed:    -5,
+			weight:   75.0,
+			duration: 1 * time.Hour,
+			activity: "Ходьба",
+			wantErr:  true,
+		},
+		{
+			name:     "нулевой вес",
+			speed:    5,
+			weight:   0,
+			duration: 1 * time.Hour,
+			activity: "Бег",
+			wantErr:  true,
+		},
+		{
+			name:     "нулевая продолжительность",
+			speed:    5,
+			weight:   75.0,
+			duration: 0,
+			activity: "Бег",
+			wantErr:  true,
+		},
+		{
+			name:     "неизвестный тип тренировки",
+			speed:    5,
+			weight:   75.0,
+			duration: 1 * time.Hour,
+			activity: "Йога",
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			gotCal, err := CaloriesFromSpeed(tt.speed, tt.weight, tt.duration, tt.activity)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, gotCal)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, gotCal, 0.001)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestRowingCaloriesFromPower() {
+	tests := []struct {
+		name     string
+		watts    float64
+		weight   float64
+		duration time.Duration
+		wantCal  float64
+		wantErr  bool
+	}{
+		{
+			name:     "средняя мощность - один час",
+			watts:    150,
+			weight:   75.0,
+			duration: 1 * time.Hour,
+			wantCal:  591.25,
+		},
+		{
+			name:     "высокая мощность - полчаса",
+			watts:    250,
+			weight:   80.0,
+			duration: 30 * time.Minute,
+			wantCal:  470.21,
+		},
+		{
+			name:     "нулевая мощность",
+			watts:    0,
+			weight:   75.0,
+			duration: 1 * time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "отрицательная мощность",
+			watts:    -100,
+			weight:   75.0,
+			duration: 1 * time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевой вес",
+			watts:    150,
+			weight:   0,
+			duration: 1 * time.Hour,
+			wantErr:  true,
+		},
+		{
+			name:     "нулевая продолжительность",
+			watts:    150,
+			weight:   75.0,
+			duration: 0,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			gotCal, err := RowingCaloriesFromPower(tt.watts, tt.weight, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, gotCal)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, gotCal, 0.01)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestCyclingSpentCalories() {
+	tests := []struct {
+		name       string
+		distanceKm float64
+		weight     float64
+		duration   time.Duration
+		wantCal    float64
+		wantErr    bool
+	}{
+		{
+			name:       "нормальная нагрузка - один час",
+			distanceKm: 25.4,
+			weight:     75.0,
+			duration:   1 * time.Hour,
+			wantCal:    762,
+		},
+		{
+			name:       "полчаса",
+			distanceKm: 10,
+			weight:     60.0,
+			duration:   30 * time.Minute,
+			wantCal:    240,
+		},
+		{
+			name:       "нулевая дистанция",
+			distanceKm: 0,
+			weight:     75.0,
+			duration:   1 * time.Hour,
+			wantErr:    true,
+		},
+		{
+			name:       "нулевой вес",
+			distanceKm: 25.4,
+			weight:     0,
+			duration:   1 * time.Hour,
+			wantErr:    true,
+		},
+		{
+			name:       "отрицательная продолжительность",
+			distanceKm: 25.4,
+			weight:     75.0,
+			duration:   -1 * time.Hour,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			gotCal, err := CyclingSpentCalories(tt.distanceKm, tt.weight, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, gotCal)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, gotCal, 0.01)
+		})
+	}
+
+	_, err := CyclingSpentCalories(-5, 75.0, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDistance)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoCycling() {
+	got, err := TrainingInfo("25400,Велоспорт,1h00m", 75.0, 1.75)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Велоспорт\nДлительность: 1.00 ч.\nДистанция: 25.40 км.\nСкорость: 25.40 км/ч\nТемп: 2:22 мин/км\nСожгли калорий: 762.00\n", got)
+
+	got, err = TrainingInfo("0,Велоспорт,1h00m", 75.0, 1.75)
+	assert.Error(suite.T(), err)
+	assert.Empty(suite.T(), got)
+
+	walking, err := ParseTrainingData("25400,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	cycling, err := ParseTrainingData("25400,Велоспорт,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.NotEqual(suite.T(), walking.Calories, cycling.Calories, "у велосипеда свой коэффициент и своя дистанция")
+
+	got, err = TrainingInfo("6000,Самокат,1h00m", 75.0, 1.75)
+	assert.ErrorContains(suite.T(), err, "неизвестный тип тренировки")
+	assert.Empty(suite.T(), got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoCyclingDistanceField() {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "дистанция в четвертом поле",
+			input: "0,Велосипед,1h30m,25.4",
+			want:  "Тип тренировки: Велосипед\nДлительность: 1.50 ч.\nДистанция: 25.40 км.\nСкорость: 16.93 км/ч\nТемп: 3:33 мин/км\nСожгли калорий: 762.00\n",
+		},
+		{
+			name:  "дистанция в метрах в первом поле",
+			input: "25400,Велосипед,1h30m",
+			want:  "Тип тренировки: Велосипед\nДлительность: 1.50 ч.\nДистанция: 25.40 км.\nСкорость: 16.93 км/ч\nТемп: 3:33 мин/км\nСожгли калорий: 762.00\n",
+		},
+		{
+			name:    "нулевая дистанция",
+			input:   "0,Велосипед,1h30m,0",
+			wantErr: true,
+		},
+		{
+			name:    "нечисловая дистанция",
+			input:   "0,Велосипед,1h30m,far",
+			wantErr: true,
+		},
+		{
+			name:    "дистанция для ходьбы",
+			input:   "0,Ходьба,1h30m,25.4",
+			wantErr: true,
+		},
+		{
+			name:    "ноль шагов без дистанции",
+			input:   "0,Велосипед,1h30m",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfo(tt.input, 75.0, 1.75)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestSwimmingSpentCalories() {
+	tests := []struct {
+		name       string
+		lengths    int
+		poolLength float64
+		weight     float64
+		duration   time.Duration
+		wantCal    float64
+		wantErr    bool
+	}{
+		{
+			name:       "40 бассейнов по 50 м за 45 минут",
+			lengths:    40,
+			poolLength: 50,
+			weight:     75.0,
+			duration:   45 * time.Minute,
+			wantCal:    423.75,
+		},
+		{
+			name:       "бассейн 25 м",
+			lengths:    40,
+			poolLength: 25,
+			weight:     60.0,
+			duration:   30 * time.Minute,
+			wantCal:    186,
+		},
+		{
+			name:       "нулевая длина бассейна",
+			lengths:    40,
+			poolLength: 0,
+			weight:     75.0,
+			duration:   45 * time.Minute,
+			wantErr:    true,
+		},
+		{
+			name:       "отрицательная длина бассейна",
+			lengths:    40,
+			poolLength: -25,
+			weight:     75.0,
+			duration:   45 * time.Minute,
+			wantErr:    true,
+		},
+		{
+			name:       "слишком длинный бассейн",
+			lengths:    40,
+			poolLength: 5000,
+			weight:     75.0,
+			duration:   45 * time.Minute,
+			wantErr:    true,
+		},
+		{
+			name:       "ноль бассейнов",
+			lengths:    0,
+			poolLength: 50,
+			weight:     75.0,
+			duration:   45 * time.Minute,
+			wantErr:    true,
+		},
+		{
+			name:       "нулевой вес",
+			lengths:    40,
+			poolLength: 50,
+			weight:     0,
+			duration:   45 * time.Minute,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			gotCal, err := SwimmingSpentCalories(tt.lengths, tt.poolLength, tt.weight, tt.duration)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Equal(suite.T(), 0.0, gotCal)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, gotCal, 0.01)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoSwimming() {
+	got, err := TrainingInfo("40,Плавание,45m,50", 75.0, 1.75)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Плавание\nДлительность: 0.75 ч.\nДистанция: 2.00 км.\nСкорость: 2.67 км/ч\nТемп: 22:30 мин/км\nСожгли калорий: 423.75\n", got)
+
+	for _, input := range []string{"40,Плавание,45m", "40,Плавание,45m,0", "40,Плавание,45m,500"} {
+		got, err = TrainingInfo(input, 75.0, 1.75)
+		assert.Error(suite.T(), err, input)
+		assert.Empty(suite.T(), got)
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestSwimmingSpentCaloriesByDistance() {
+	// 1 км за 30 минут: (2 + 1.1) * 2 * 75 * 0.5.
+	got, err := SwimmingSpentCaloriesByDistance(1000, 75.0, 30*time.Minute)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 232.5, got, 1e-9)
+
+	// то же, что 40 бассейнов по 25 м.
+	byLengths, err := SwimmingSpentCalories(40, 25, 75.0, 30*time.Minute)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), got, byLengths, 1e-9)
+
+	info, err := TrainingInfo("40,Плавание,30m,25", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), info, "Дистанция: 1.00 км.\n")
+	assert.Contains(suite.T(), info, "Сожгли калорий: 232.50\n")
+
+	_, err = SwimmingSpentCaloriesByDistance(0, 75.0, 30*time.Minute)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDistance)
+
+	_, err = SwimmingSpentCaloriesByDistance(1000, 0, 30*time.Minute)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveWeight)
+
+	_, err = SwimmingSpentCaloriesByDistance(1000, 75.0, 0)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDuration)
+}
+
+func (suite *SpentCaloriesTestSuite) TestDistanceWithStep() {
+	assert.InDelta(suite.T(), 0.7875, StepLength(1.75), 1e-9)
+	assert.Equal(suite.T(), 4.8, DistanceWithStep(6000, 1.75, 0.8))
+	assert.Equal(suite.T(), distance(6000, 1.75), DistanceWithStep(6000, 1.75, 0))
+	assert.Equal(suite.T(), distance(6000, 1.75), DistanceWithStep(6000, 1.75, -1))
+}
+
+func (suite *SpentCaloriesTestSuite) TestMeanPace() {
+	// 6000 шагов при росте 1.75 — 4.725 км за час: 12 минут 41 секунда на километр.
+	assert.InDelta(suite.T(), float64(time.Hour)/4.725, float64(MeanPace(6000, 1.75, time.Hour)), 1)
+	assert.Equal(suite.T(), time.Duration(0), MeanPace(6000, 1.75, 0))
+	assert.Equal(suite.T(), time.Duration(0), MeanPace(0, 1.75, time.Hour))
+}
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesByMET() {
+	tests := []struct {
+		name     string
+		met      float64
+		weight   float64
+		duration time.Duration
+		wantCal  float64
+		wantErr  string
+	}{
+		{
+			name:     "бег - один час",
+			met:      8.0,
+			weight:   75.0,
+			duration: 1 * time.Hour,
+			wantCal:  600,
+		},
+		{
+			name:     "ходьба - полчаса",
+			met:      3.5,
+			weight:   80.0,
+			duration: 30 * time.Minute,
+			wantCal:  140,
+		},
+		{
+			name:     "нулевой MET",
+			met:      0,
+			weight:   75.0,
+			duration: 1 * time.Hour,
+			wantErr:  "met is not positive",
+		},
+		{
+			name:     "нулевой вес",
+			met:      8.0,
+			weight:   0,
+			duration: 1 * time.Hour,
+			wantErr:  ErrNonPositiveWeight.Error(),
+		},
+		{
+			name:     "отрицательная продолжительность",
+			met:      8.0,
+			weight:   75.0,
+			duration: -time.Hour,
+			wantErr:  ErrNonPositiveDuration.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			gotCal, err := CaloriesByMET(tt.met, tt.weight, tt.duration)
+
+			if tt.wantErr != "" {
+				assert.EqualError(suite.T(), err, tt.wantErr)
+				assert.Equal(suite.T(), 0.0, gotCal)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), tt.wantCal, gotCal, 0.01)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingWhitespace() {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "пробелы после запятых", input: "3456, Ходьба, 3h00m"},
+		{name: "пробелы вокруг полей", input: " 3456 , Ходьба , 3h00m "},
+		{name: "табуляции", input: "\t3456,\tХодьба\t,3h00m\t"},
+		{name: "точка с запятой", input: "3456;Ходьба;3h00m"},
+		{name: "точка с запятой и пробелы", input: "3456; Ходьба; 3h00m"},
+		{name: "BOM в начале", input: "\ufeff3456,Ходьба,3h00m"},
+		{name: "BOM и точка с запятой", input: "\ufeff3456;Ходьба;3h00m"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			steps, activity, d, err := ParseTraining(tt.input)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), 3456, steps)
+			assert.Equal(suite.T(), "Ходьба", activity)
+			assert.Equal(suite.T(), 3*time.Hour, d)
+		})
+	}
+
+	for _, input := range []string{"3456,Ходьба", "3456;Ходьба;3h00m;1;2", "3456;Ходьба,3h00m"} {
+		_, _, _, err := ParseTraining(input)
+		assert.ErrorIs(suite.T(), err, ErrBadDataFormat, input)
+	}
+
+	got, err := TrainingInfo("6000, Ходьба, 1h00m, terrain=snow", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Покрытие: snow (x1.60)\n")
+
+	want, err := TrainingInfo("6000,Ходьба,1h00m,terrain=snow", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got, err = TrainingInfo("\ufeff6000; Ходьба; 1h00m; terrain=snow", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingFieldOrder() {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{name: "обычный порядок", input: "3456,Ходьба,3h00m"},
+		{name: "активность первой", input: "Ходьба,3456,3h00m"},
+		{name: "продолжительность первой", input: "3h00m,Ходьба,3456"},
+		{name: "активность последней", input: "3456,3h00m,Ходьба"},
+		{name: "продолжительность в формате ЧЧ:ММ:СС", input: "Ходьба,3:00:00,3456"},
+		{name: "с пробелами", input: " Ходьба , 3456 , 3h00m "},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			steps, activity, d, err := ParseTraining(tt.input)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), 3456, steps)
+			assert.Equal(suite.T(), "Ходьба", activity)
+			assert.Equal(suite.T(), 3*time.Hour, d)
+		})
+	}
+
+	badFormat := []string{
+		"Ходьба,Бег,3h00m",  // нет шагов.
+		"3456,Ходьба,Бег",   // нет продолжительности.
+		"Ходьба,0,0",        // два поля с числами.
+		"3h00m,Ходьба,1h00", // две продолжительности.
+		"что-то,совсем,не то",
+	}
+	for _, input := range badFormat {
+		_, _, _, err := ParseTraining(input)
+		assert.ErrorIs(suite.T(), err, ErrBadDataFormat, input)
+	}
+
+	want, err := TrainingInfo("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got, err := TrainingInfo("Бег,6000,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	got, err = TrainingInfo("1h00m,Ходьба,6000,terrain=snow", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Тип тренировки: Ходьба\n")
+	assert.Contains(suite.T(), got, "Покрытие: snow (x1.60)\n")
+
+	// для велосипеда с дистанцией шагов может не быть и в другом порядке.
+	got, err = TrainingInfo("Велоспорт,0,1h30m,25.4", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Дистанция: 25.40 км.\n")
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingExported() {
+	steps, activity, d, err := ParseTraining("3456,Ходьба,3h00m")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3456, steps)
+	assert.Equal(suite.T(), "Ходьба", activity)
+	assert.Equal(suite.T(), 3*time.Hour, d)
+
+	_, _, _, err = ParseTraining("3456,Ходьба")
+	assert.ErrorIs(suite.T(), err, ErrBadDataFormat)
+
+	_, _, _, err = ParseTraining("0,Ходьба,3h00m")
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+
+	// ParseTraining принимает те же записи, что и TrainingInfo.
+	for _, input := range []string{
+		"0,Велосипед,1h30m,25.4",
+		"40,Плавание,45m,50",
+		"5600,Бег,40m,156",
+		"3456,Ходьба,3h00m,terrain=snow",
+		"6000, бег ,40m",
+	} {
+		_, err := TrainingInfo(input, 75.0, 1.75)
+		assert.NoError(suite.T(), err, input)
+
+		_, _, _, err = ParseTraining(input)
+		assert.NoError(suite.T(), err, input)
+	}
+
+	steps, activity, d, err = ParseTraining("Бег;40m;5600;156")
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 5600, steps)
+	assert.Equal(suite.T(), "Бег", activity)
+	assert.Equal(suite.T(), 40*time.Minute, d)
+
+	_, _, _, err = ParseTraining("5600,Бег,40m,terrain")
+	assert.ErrorIs(suite.T(), err, ErrBadDataFormat)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoFields() {
+	for _, input := range []string{
+		"6000,Бег,1h00m",
+		"3000,Ходьба,30m",
+		"6000, бег ,40m",
+		"25400,Велоспорт,1h30m",
+		"12000,Велосипед,40m",
+		"40,Плавание,45m",
+		"Ходьба,3456,3h00m",
+		"6000,Йога,1h00m",
+	} {
+		want, wantErr := TrainingInfo(input, 75.0, 1.75)
+
+		steps, activity, d, err := ParseTraining(input)
+		assert.NoError(suite.T(), err, input)
+
+		got, err := TrainingInfoFields(steps, activity, d, 75.0, 1.75)
+		assert.Equal(suite.T(), want, got, input)
+		if wantErr != nil {
+			assert.EqualError(suite.T(), err, wantErr.Error(), input)
+		} else {
+			assert.NoError(suite.T(), err, input)
+		}
+	}
+
+	_, err := TrainingInfoFields(0, "Бег", time.Hour, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveSteps)
+
+	_, err = TrainingInfoFields(6000, "Бег", 0, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDuration)
+
+	_, err = TrainingInfoFields(6000, "Бег", time.Hour, 75.0, 0)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveHeight)
+}
+
+func (suite *SpentCaloriesTestSuite) TestPaceZeroDistance() {
+	assert.Equal(suite.T(), 6*time.Minute, pace(10, time.Hour))
+	assert.Equal(suite.T(), time.Duration(0), pace(0, time.Hour))
+	assert.Equal(suite.T(), time.Duration(0), pace(-1, time.Hour))
+}
+
+func (suite *SpentCaloriesTestSuite) TestStepsFromDistance() {
+	assert.Equal(suite.T(), 6000, StepsFromDistance(4.725, 1.75))
+	assert.Equal(suite.T(), 1270, StepsFromDistance(1, 1.75))
+	assert.Equal(suite.T(), 0, StepsFromDistance(0, 1.75))
+	assert.Equal(suite.T(), 0, StepsFromDistance(5, 0))
+
+	for _, steps := range []int{1, 678, 12345} {
+		assert.Equal(suite.T(), steps, StepsFromDistance(distance(steps, 1.8), 1.8))
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestSpentCaloriesByDistance() {
+	// 6000 шагов при росте 1.75 — это 4.725 км.
+	running, err := RunningSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	got, err := RunningSpentCaloriesByDistance(4.725, 75.0, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), running, got, 1e-9)
+
+	walking, err := WalkingSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	got, err = WalkingSpentCaloriesByDistance(4.725, 75.0, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), walking, got, 1e-9)
+
+	_, err = RunningSpentCaloriesByDistance(0, 75.0, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDistance)
+	_, err = WalkingSpentCaloriesByDistance(5, 0, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveWeight)
+	_, err = WalkingSpentCaloriesByDistance(5, 75.0, 0)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDuration)
+}
+
+func (suite *SpentCaloriesTestSuite) TestCadence() {
+	assert.InDelta(suite.T(), 115.2, Cadence(3456, 30*time.Minute), 1e-9)
+	assert.Equal(suite.T(), 0.0, Cadence(3456, 0))
+
+	got, err := TrainingInfo("3456,Бег,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Каденс: 115.20 шаг/мин\n")
+
+	got, err = TrainingInfo("0,Велосипед,1h30m,25.4", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.NotContains(suite.T(), got, "Каденс")
+}
+
+func (suite *SpentCaloriesTestSuite) TestRunningSpentCaloriesZeroHeight() {
+	for _, height := range []float64{0, -1.75} {
+		got, err := RunningSpentCalories(6000, 75.0, height, time.Hour)
+		assert.ErrorIs(suite.T(), err, ErrNonPositiveHeight)
+		assert.Equal(suite.T(), 0.0, got)
+	}
+}
@@ -0,0 +1,359 @@
+package spentcalories
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Summary — сводка по нескольким тренировкам.
+type Summary struct {
+	Count              int                // количество успешно обработанных записей.
+	Failed             int                // количество записей, которые не удалось обработать.
+	TotalSteps         int                // общее количество шагов.
+	TotalDistanceKm    float64            // общая дистанция в километрах.
+	TotalDuration      time.Duration      // общая продолжительность тренировок.
+	TotalCalories      float64            // общее количество потраченных калорий.
+	CaloriesByActivity map[string]float64 // потраченные калории по видам активности, см. canonicalActivity.
+	CountByActivity    map[string]int     // количество тренировок по видам активности, см. canonicalActivity.
+}
+
+// DaySummary — сводка по тренировкам за день, см. Summarize.
+type DaySummary = Summary
+
+// add добавляет тренировку t в сводку.
+func (s *Summary) add(t Training) {
+	if s.CaloriesByActivity == nil {
+		s.CaloriesByActivity = make(map[string]float64)
+		s.CountByActivity = make(map[string]int)
+	}
+
+	s.Count++
+	s.TotalSteps += t.Steps
+	s.TotalDistanceKm += t.Distance
+	s.TotalDuration += t.Duration
+	s.TotalCalories += t.Calories
+	activity := canonicalActivity(t.Activity)
+	s.CaloriesByActivity[activity] += t.Calories
+	s.CountByActivity[activity]++
+}
+
+// String возвращает сводку в формате, похожем на TrainingInfo:
+//
+//	Количество тренировок: 2
+//	Ошибочных записей: 0
+//	Длительность: 2.00 ч.
+//	Дистанция: 9.45 км.
+//	Количество шагов: 12000
+//	Сожгли калорий: 531.56
+//	- Бег: 354.38
+//	- Ходьба: 177.19
+func (s Summary) String() string {
+	text := `Количество тренировок: %d
+Ошибочных записей: %d
+Длительность: %.2f ч.
+Дистанция: %.2f км.
+Количество шагов: %d
+Сожгли калорий: %.2f
+`
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, text, s.Count, s.Failed, s.TotalDuration.Hours(), s.TotalDistanceKm, s.TotalSteps, s.TotalCalories)
+
+	activities := make([]string, 0, len(s.CaloriesByActivity))
+	for activity := range s.CaloriesByActivity {
+		activities = append(activities, activity)
+	}
+	slices.Sort(activities)
+
+	for _, activity := range activities {
+		fmt.Fprintf(&sb, "- %s: %.2f\n", activity, s.CaloriesByActivity[activity])
+	}
+
+	return sb.String()
+}
+
+// Summarize суммирует уже рассчитанные тренировки results любых видов
+// активности. Для пустого списка возвращается нулевая сводка.
+func Summarize(results []TrainingResult) DaySummary {
+	var summary DaySummary
+
+	for _, t := range results {
+		summary.add(t)
+	}
+
+	return summary
+}
+
+// ProcessTrainings принимает:
+// records []string — записи о тренировках в формате TrainingInfo.
+// weight, height float64 — вес (кг.) и рост (м.) пользователя.
+//
+// Ошибочные записи не прерывают обработку: они учитываются в Summary.Failed,
+// а их ошибки с индексом записи объединяются через errors.Join.
+//
+// Возвращает:
+// Summary — сводку по успешно обработанным записям.
+// error — ошибки ошибочных записей или nil, если таких нет.
+func ProcessTrainings(records []string, weight, height float64) (Summary, error) {
+	return ProcessTrainingsContext(context.Background(), records, weight, height)
+}
+
+// ProcessTrainingsContext — то же, что ProcessTrainings, но контекст ctx
+// проверяется перед каждой записью. Если он отменен, обработка прекращается
+// и возвращается сводка по уже обработанным записям вместе с ctx.Err(),
+// обернутой с номером записи, и ошибками ошибочных записей.
+func ProcessTrainingsContext(ctx context.Context, records []string, weight, height float64) (Summary, error) {
+	var (
+		summary Summary
+		errs    []error
+	)
+
+	for i, record := range records {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, fmt.Errorf("stopped before record %d: %w", i, err))
+			break
+		}
+
+		t, err := ParseTrainingData(record, weight, height)
+		if err != nil {
+			summary.Failed++
+			errs = append(errs, fmt.Errorf("record %d: %w", i, err))
+			continue
+		}
+
+		summary.add(t)
+	}
+
+	return summary, errors.Join(errs...)
+}
+
+// ProcessTrainingsConcurrent — то же, что ProcessTrainings, но записи
+// разбираются параллельно в workers горутинах; если workers не больше нуля,
+// их число равно runtime.NumCPU(). Каждая горутина разбирает свой непрерывный
+// участок records, а сводка затем собирается в порядке записей, поэтому
+// результат и ошибки в точности совпадают с ProcessTrainings.
+func ProcessTrainingsConcurrent(records []string, weight, height float64, workers int) (Summary, error) {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	trainings := make([]Training, len(records))
+	parseErrs := make([]error, len(records))
+
+	chunk := (len(records) + workers - 1) / workers
+
+	var wg sync.WaitGroup
+	for lo := 0; lo < len(records); lo += chunk {
+		hi := min(lo+chunk, len(records))
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := lo; i < hi; i++ {
+				trainings[i], parseErrs[i] = ParseTrainingData(records[i], weight, height)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var (
+		summary Summary
+		errs    []error
+	)
+
+	for i, t := range trainings {
+		if parseErrs[i] != nil {
+			summary.Failed++
+			errs = append(errs, fmt.Errorf("record %d: %w", i, parseErrs[i]))
+			continue
+		}
+
+		summary.add(t)
+	}
+
+	return summary, errors.Join(errs...)
+}
+
+// DailySummary — то же, что ProcessTrainings, но без пропуска ошибочных записей:
+// обработка останавливается на первой ошибке, которая возвращается вместе
+// с индексом записи.
+func DailySummary(entries []string, weight, height float64) (Summary, error) {
+	return DailySummaryContext(context.Background(), entries, weight, height)
+}
+
+// ctxCheckInterval — через сколько записей DailySummaryContext проверяет контекст.
+const ctxCheckInterval = 100
+
+// DailySummaryContext — то же, что DailySummary, но обработка прерывается,
+// если контекст ctx отменен. Контекст проверяется перед первой записью
+// и затем через каждые ctxCheckInterval записей; при отмене возвращается
+// ctx.Err() без частичной сводки.
+func DailySummaryContext(ctx context.Context, entries []string, weight, height float64) (Summary, error) {
+	var summary Summary
+
+	for i, entry := range entries {
+		if i%ctxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				return Summary{}, err
+			}
+		}
+
+		t, err := ParseTrainingData(entry, weight, height)
+		if err != nil {
+			return Summary{}, fmt.Errorf("entry %d: %w", i, err)
+		}
+
+		summary.add(t)
+	}
+
+	return summary, nil
+}
+
+// WeeklyReport принимает:
+// entries map[string][]string — записи о тренировках по дням, ключ — дата в формате ГГГГ-ММ-ДД.
+// weight, height float64 — вес (кг.) и рост (м.) пользователя.
+//
+// Записи каждого дня сводятся через DailySummary. Дни обрабатываются
+// по порядку дат, и на первой ошибке обработка останавливается.
+//
+// Возвращает:
+// map[string]Summary — сводку по каждому дню.
+// error — ошибку с датой и индексом записи или nil.
+func WeeklyReport(entries map[string][]string, weight, height float64) (map[string]Summary, error) {
+	dates := make([]string, 0, len(entries))
+	for date := range entries {
+		dates = append(dates, date)
+	}
+	slices.Sort(dates)
+
+	report := make(map[string]Summary, len(entries))
+
+	for _, date := range dates {
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			return nil, fmt.Errorf("date %q: %w: %w", date, ErrBadDataFormat, err)
+		}
+
+		summary, err := DailySummary(entries[date], weight, height)
+		if err != nil {
+			return nil, fmt.Errorf("date %s: %w", date, err)
+		}
+
+		report[date] = summary
+	}
+
+	return report, nil
+}
+
+// timestampLayouts — форматы необязательной метки времени в начале записи.
+var timestampLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02T15:04", time.DateOnly}
+
+// parseTrainingWithTime отделяет от записи необязательную метку времени,
+// например "2024-06-01T08:15,3456,Ходьба,1h". Метка отделяется тем же
+// разделителем, что и остальные поля, см. recordSep:
+// "2024-06-01T08:15;3456;Ходьба;1h".
+//
+// Возвращает:
+// time.Time — метку времени или нулевое время, если ее нет.
+// string — запись без метки времени.
+func parseTrainingWithTime(data string) (time.Time, string) {
+	first, rest, ok := strings.Cut(data, recordSep(data))
+	if !ok {
+		return time.Time{}, data
+	}
+
+	first = strings.TrimSpace(strings.TrimPrefix(first, bom))
+	for _, layout := range timestampLayouts {
+		if ts, err := time.Parse(layout, first); err == nil {
+			return ts, rest
+		}
+	}
+
+	return time.Time{}, data
+}
+
+// GroupByDay принимает:
+// records []string — записи о тренировках, перед которыми может стоять
+// метка времени: "2024-06-01T08:15,3456,Ходьба,1h".
+// weight, height float64 — вес (кг.) и рост (м.) пользователя.
+//
+// Записи группируются по дате метки времени в формате ГГГГ-ММ-ДД, записи
+// без метки попадают в группу с ключом "". Как и в ProcessTrainings,
+// ошибочные записи не прерывают обработку и учитываются в Summary.Failed своего дня.
+//
+// Возвращает:
+// map[string]DaySummary — сводку по каждому дню.
+// error — объединенные через errors.Join ошибки с индексами записей или nil.
+func GroupByDay(records []string, weight, height float64) (map[string]DaySummary, error) {
+	days := make(map[string]DaySummary)
+	var errs []error
+
+	for i, record := range records {
+		ts, rest := parseTrainingWithTime(record)
+
+		day := ""
+		if !ts.IsZero() {
+			day = ts.Format(time.DateOnly)
+		}
+
+		summary := days[day]
+
+		t, err := ParseTrainingData(rest, weight, height)
+		if err != nil {
+			summary.Failed++
+			errs = append(errs, fmt.Errorf("record %d: %w", i, err))
+		} else {
+			summary.add(t)
+		}
+
+		days[day] = summary
+	}
+
+	return days, errors.Join(errs...)
+}
+
+// DatedTraining — тренировка с датой и временем, когда она была.
+type DatedTraining struct {
+	Date time.Time // дата и время тренировки.
+	Training
+}
+
+// SummarizeByDay суммирует тренировки records по дням. Ключ — дата тренировки
+// в ее часовом поясе в формате ГГГГ-ММ-ДД. Для пустого списка возвращается
+// пустая карта.
+func SummarizeByDay(records []DatedTraining) map[string]DaySummary {
+	days := make(map[string]DaySummary)
+
+	for _, r := range records {
+		day := r.Date.Format(time.DateOnly)
+
+		summary := days[day]
+		summary.add(r.Training)
+		days[day] = summary
+	}
+
+	return days
+}
+
+// SummarizeRange суммирует тренировки records, дата которых не раньше from
+// и раньше to. Например, сводку за неделю дает
+// SummarizeRange(records, monday, monday.AddDate(0, 0, 7)).
+func SummarizeRange(records []DatedTraining, from, to time.Time) DaySummary {
+	var summary DaySummary
+
+	for _, r := range records {
+		if r.Date.Before(from) || !r.Date.Before(to) {
+			continue
+		}
+
+		summary.add(r.Training)
+	}
+
+	return summary
+}
@@ -0,0 +1,374 @@
+package spentcalories
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestProcessTrainings() {
+	records := []string{
+		"6000,Бег,1h00m",
+		"something is wrong",
+		"6000,Ходьба,1h00m",
+		"6000,Йога,1h00m",
+	}
+
+	got, err := ProcessTrainings(records, 75.0, 1.75)
+
+	assert.Error(suite.T(), err)
+	assert.ErrorContains(suite.T(), err, "record 1:")
+	assert.ErrorContains(suite.T(), err, "record 3:")
+	assert.True(suite.T(), errors.Is(err, ErrUnknownActivity))
+
+	assert.Equal(suite.T(), 2, got.Count)
+	assert.Equal(suite.T(), 2, got.Failed)
+	assert.Equal(suite.T(), 12000, got.TotalSteps)
+	assert.InDelta(suite.T(), 9.45, got.TotalDistanceKm, 1e-9)
+	assert.Equal(suite.T(), 2*time.Hour, got.TotalDuration)
+	assert.InDelta(suite.T(), 531.5625, got.TotalCalories, 1e-9)
+	assert.InDelta(suite.T(), 354.375, got.CaloriesByActivity["Бег"], 1e-9)
+	assert.InDelta(suite.T(), 177.1875, got.CaloriesByActivity["Ходьба"], 1e-9)
+
+	want := "Количество тренировок: 2\nОшибочных записей: 2\nДлительность: 2.00 ч.\nДистанция: 9.45 км.\nКоличество шагов: 12000\nСожгли калорий: 531.56\n- Бег: 354.38\n- Ходьба: 177.19\n"
+	assert.Equal(suite.T(), want, got.String())
+}
+
+func (suite *SpentCaloriesTestSuite) TestProcessTrainingsNoErrors() {
+	got, err := ProcessTrainings([]string{"3000,Ходьба,30m"}, 75.0, 1.75)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 1, got.Count)
+	assert.Equal(suite.T(), 0, got.Failed)
+
+	got, err = ProcessTrainings(nil, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), Summary{}, got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestProcessTrainingsContext() {
+	records := benchRecords(1000)
+
+	want, wantErr := ProcessTrainings(records, 75.0, 1.75)
+
+	got, err := ProcessTrainingsContext(context.Background(), records, 75.0, 1.75)
+	assert.Equal(suite.T(), want, got)
+	assert.Equal(suite.T(), wantErr.Error(), err.Error())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	got, err = ProcessTrainingsContext(ctx, records, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+	assert.EqualError(suite.T(), err, "stopped before record 0: context canceled")
+	assert.Equal(suite.T(), Summary{}, got)
+
+	// контекст отменяется на 501-й проверке, то есть перед записью 500.
+	counting := &countingContext{Context: context.Background(), cancelAt: 501}
+	got, err = ProcessTrainingsContext(counting, records, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+	assert.ErrorContains(suite.T(), err, "stopped before record 500:")
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+
+	partial, _ := ProcessTrainings(records[:500], 75.0, 1.75)
+	assert.Equal(suite.T(), partial, got)
+	assert.Equal(suite.T(), 450, got.Count)
+	assert.Equal(suite.T(), 50, got.Failed)
+}
+
+// benchRecords возвращает n записей о тренировках разных видов, каждая
+// десятая из которых ошибочна.
+func benchRecords(n int) []string {
+	kinds := []string{
+		"6000,Бег,1h00m",
+		"3000,Ходьба,30m",
+		"0,Велоспорт,1h30m,25.4",
+		"40,Плавание,45m,50",
+		"5600,Бег,40m,156",
+		"4000,Ходьба,50m,terrain=snow",
+		"7000,Бег,45m",
+		"2500,Ходьба,25m",
+		"12000,Велосипед,40m",
+		"6000,Йога,1h00m",
+	}
+
+	records := make([]string, n)
+	for i := range records {
+		records[i] = kinds[i%len(kinds)]
+	}
+
+	return records
+}
+
+func (suite *SpentCaloriesTestSuite) TestProcessTrainingsConcurrent() {
+	records := benchRecords(1003)
+
+	want, wantErr := ProcessTrainings(records, 75.0, 1.75)
+
+	for _, workers := range []int{-1, 0, 1, 3, 8, 2000} {
+		got, err := ProcessTrainingsConcurrent(records, 75.0, 1.75, workers)
+		assert.Equal(suite.T(), want, got, "workers: %d", workers)
+		assert.Equal(suite.T(), wantErr.Error(), err.Error(), "workers: %d", workers)
+		assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+	}
+
+	got, err := ProcessTrainingsConcurrent(nil, 75.0, 1.75, 4)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), Summary{}, got)
+}
+
+func BenchmarkProcessTrainings(b *testing.B) {
+	records := benchRecords(100000)
+
+	b.Run("sequential", func(b *testing.B) {
+		for b.Loop() {
+			_, _ = ProcessTrainings(records, 75.0, 1.75)
+		}
+	})
+
+	b.Run("concurrent", func(b *testing.B) {
+		for b.Loop() {
+			_, _ = ProcessTrainingsConcurrent(records, 75.0, 1.75, 0)
+		}
+	})
+}
+
+func (suite *SpentCaloriesTestSuite) TestDailySummary() {
+	got, err := DailySummary([]string{"6000,Бег,1h00m", "6000,Ходьба,1h00m", "25400,Велоспорт,1h00m"}, 75.0, 1.75)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, got.Count)
+	assert.Equal(suite.T(), 12000, got.TotalSteps)
+	assert.InDelta(suite.T(), 34.85, got.TotalDistanceKm, 1e-9)
+	assert.Equal(suite.T(), 3*time.Hour, got.TotalDuration)
+	assert.InDelta(suite.T(), 1293.5625, got.TotalCalories, 1e-9)
+	assert.Equal(suite.T(), map[string]float64{"Бег": 354.375, "Ходьба": 177.1875, "Велоспорт": 762}, got.CaloriesByActivity)
+
+	got, err = DailySummary([]string{"6000,Бег,1h00m", "6000,Бег,0h"}, 75.0, 1.75)
+	assert.ErrorContains(suite.T(), err, "entry 1:")
+	assert.True(suite.T(), errors.Is(err, ErrNonPositiveDuration))
+	assert.Equal(suite.T(), Summary{}, got)
+}
+
+// countingContext — контекст, который считает вызовы Err и считается
+// отмененным начиная с вызова cancelAt.
+type countingContext struct {
+	context.Context
+	calls    int
+	cancelAt int
+}
+
+func (c *countingContext) Err() error {
+	c.calls++
+	if c.calls >= c.cancelAt {
+		return context.Canceled
+	}
+
+	return nil
+}
+
+func (suite *SpentCaloriesTestSuite) TestDailySummaryContext() {
+	entries := make([]string, 250)
+	for i := range entries {
+		entries[i] = "6000,Бег,1h00m"
+	}
+
+	want, err := DailySummary(entries, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got, err := DailySummaryContext(context.Background(), entries, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	got, err = DailySummaryContext(ctx, entries, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+	assert.Equal(suite.T(), Summary{}, got)
+
+	ctx, cancel = context.WithTimeout(context.Background(), -time.Second)
+	defer cancel()
+
+	_, err = DailySummaryContext(ctx, entries, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, context.DeadlineExceeded)
+
+	// контекст проверяется на 0, 100 и 200 записи и отменяется на второй проверке.
+	counting := &countingContext{Context: context.Background(), cancelAt: 2}
+	got, err = DailySummaryContext(counting, entries, 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, context.Canceled)
+	assert.Equal(suite.T(), Summary{}, got)
+	assert.Equal(suite.T(), 2, counting.calls)
+
+	counting = &countingContext{Context: context.Background(), cancelAt: 4}
+	_, err = DailySummaryContext(counting, entries, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 3, counting.calls)
+}
+
+func (suite *SpentCaloriesTestSuite) TestSummarize() {
+	run, err := ParseTrainingData("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	walk, err := ParseTrainingData("6000,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got := Summarize([]TrainingResult{run, walk})
+
+	assert.Equal(suite.T(), 2, got.Count)
+	assert.Equal(suite.T(), 12000, got.TotalSteps)
+	assert.InDelta(suite.T(), 9.45, got.TotalDistanceKm, 1e-9)
+	assert.Equal(suite.T(), 2*time.Hour, got.TotalDuration)
+	assert.InDelta(suite.T(), 531.5625, got.TotalCalories, 1e-9)
+
+	want := "Количество тренировок: 2\nОшибочных записей: 0\nДлительность: 2.00 ч.\nДистанция: 9.45 км.\nКоличество шагов: 12000\nСожгли калорий: 531.56\n- Бег: 354.38\n- Ходьба: 177.19\n"
+	assert.Equal(suite.T(), want, got.String())
+}
+
+func (suite *SpentCaloriesTestSuite) TestSummaryActivityAliases() {
+	got, err := ProcessTrainings([]string{"6000,Бег,1h00m", "6000,Running,1h00m", "6000, running ,1h00m"}, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	assert.Equal(suite.T(), map[string]int{"Бег": 3}, got.CountByActivity)
+	assert.Len(suite.T(), got.CaloriesByActivity, 1)
+	assert.InDelta(suite.T(), 3*354.375, got.CaloriesByActivity["Бег"], 1e-9)
+	assert.Contains(suite.T(), got.String(), "- Бег: 1063.12\n")
+}
+
+func (suite *SpentCaloriesTestSuite) TestSummarizeEmpty() {
+	got := Summarize(nil)
+
+	assert.Equal(suite.T(), DaySummary{}, got)
+	assert.Equal(suite.T(), "Количество тренировок: 0\nОшибочных записей: 0\nДлительность: 0.00 ч.\nДистанция: 0.00 км.\nКоличество шагов: 0\nСожгли калорий: 0.00\n", got.String())
+}
+
+func (suite *SpentCaloriesTestSuite) TestWeeklyReport() {
+	entries := map[string][]string{
+		"2024-05-06": {"6000,Бег,1h00m", "6000,Ходьба,1h00m"},
+		"2024-05-07": {"3000,Ходьба,30m"},
+		"2024-05-08": {},
+	}
+
+	got, err := WeeklyReport(entries, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), got, 3)
+
+	assert.Equal(suite.T(), 2, got["2024-05-06"].Count)
+	assert.InDelta(suite.T(), 531.5625, got["2024-05-06"].TotalCalories, 1e-9)
+	assert.Equal(suite.T(), 1, got["2024-05-07"].Count)
+	assert.InDelta(suite.T(), 88.59375, got["2024-05-07"].TotalCalories, 1e-9)
+	assert.Equal(suite.T(), Summary{}, got["2024-05-08"])
+
+	entries["2024-05-07"] = []string{"3000,Ходьба,30m", "3000,Йога,30m"}
+	got, err = WeeklyReport(entries, 75.0, 1.75)
+	assert.Nil(suite.T(), got)
+	assert.ErrorContains(suite.T(), err, "date 2024-05-07: entry 1:")
+	assert.True(suite.T(), errors.Is(err, ErrUnknownActivity))
+
+	_, err = WeeklyReport(map[string][]string{"07.05.2024": {"3000,Ходьба,30m"}}, 75.0, 1.75)
+	assert.True(suite.T(), errors.Is(err, ErrBadDataFormat))
+	assert.ErrorContains(suite.T(), err, `date "07.05.2024"`)
+}
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingWithTime() {
+	tests := []struct {
+		input    string
+		wantTime time.Time
+		wantRest string
+	}{
+		{input: "2024-06-01T08:15,3456,Ходьба,1h", wantTime: time.Date(2024, 6, 1, 8, 15, 0, 0, time.UTC), wantRest: "3456,Ходьба,1h"},
+		{input: "2024-06-01T08:15:30Z,3456,Ходьба,1h", wantTime: time.Date(2024, 6, 1, 8, 15, 30, 0, time.UTC), wantRest: "3456,Ходьба,1h"},
+		{input: "2024-06-01,3456,Ходьба,1h", wantTime: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), wantRest: "3456,Ходьба,1h"},
+		{input: "3456,Ходьба,1h", wantRest: "3456,Ходьба,1h"},
+		{input: "2024-06-01T08:15;3456;Ходьба;1h", wantTime: time.Date(2024, 6, 1, 8, 15, 0, 0, time.UTC), wantRest: "3456;Ходьба;1h"},
+		{input: "3456", wantRest: "3456"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.input, func() {
+			gotTime, gotRest := parseTrainingWithTime(tt.input)
+			assert.True(suite.T(), tt.wantTime.Equal(gotTime), "ожидалось %v, получено %v", tt.wantTime, gotTime)
+			assert.Equal(suite.T(), tt.wantRest, gotRest)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestGroupByDay() {
+	records := []string{
+		"2024-06-01T08:15,6000,Бег,1h00m",
+		"2024-06-01T19:00,6000,Ходьба,1h00m",
+		"2024-06-01T20:00,3000,Ходьба,30m",
+		"2024-06-02T07:30,6000,Бег,1h00m",
+		"3000,Ходьба,30m",
+		"2024-06-02T09:00,6000,Йога,1h00m",
+	}
+
+	got, err := GroupByDay(records, 75.0, 1.75)
+
+	assert.ErrorContains(suite.T(), err, "record 5:")
+	assert.True(suite.T(), errors.Is(err, ErrUnknownActivity))
+	assert.Len(suite.T(), got, 3)
+
+	day := got["2024-06-01"]
+	assert.Equal(suite.T(), 3, day.Count)
+	assert.Equal(suite.T(), 15000, day.TotalSteps)
+	assert.InDelta(suite.T(), 620.15625, day.TotalCalories, 1e-9)
+	assert.Equal(suite.T(), map[string]int{"Бег": 1, "Ходьба": 2}, day.CountByActivity)
+
+	day = got["2024-06-02"]
+	assert.Equal(suite.T(), 1, day.Count)
+	assert.Equal(suite.T(), 1, day.Failed)
+	assert.Equal(suite.T(), map[string]int{"Бег": 1}, day.CountByActivity)
+
+	day = got[""]
+	assert.Equal(suite.T(), 1, day.Count)
+	assert.Equal(suite.T(), map[string]int{"Ходьба": 1}, day.CountByActivity)
+
+	// метка времени отделяется тем же разделителем, что и остальные поля.
+	got, err = GroupByDay([]string{
+		"2024-06-01T08:15;3456;Ходьба;1h",
+		"\ufeff2024-06-01T19:00; 6000; Бег; 1h00m",
+	}, 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Len(suite.T(), got, 1)
+	assert.Equal(suite.T(), map[string]int{"Бег": 1, "Ходьба": 1}, got["2024-06-01"].CountByActivity)
+}
+
+func (suite *SpentCaloriesTestSuite) TestSummarizeByDay() {
+	run, err := ParseTrainingData("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	walk, err := ParseTrainingData("6000,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	day := func(d, h int) time.Time {
+		return time.Date(2024, time.June, d, h, 0, 0, 0, time.UTC)
+	}
+
+	records := []DatedTraining{
+		{Date: day(3, 8), Training: run},
+		{Date: day(3, 19), Training: walk},
+		{Date: day(4, 7), Training: walk},
+		{Date: day(10, 7), Training: run},
+	}
+
+	got := SummarizeByDay(records)
+	assert.Equal(suite.T(), map[string]DaySummary{
+		"2024-06-03": Summarize([]TrainingResult{run, walk}),
+		"2024-06-04": Summarize([]TrainingResult{walk}),
+		"2024-06-10": Summarize([]TrainingResult{run}),
+	}, got)
+	assert.Empty(suite.T(), SummarizeByDay(nil))
+
+	// неделя с понедельника 3 июня: запись 10 июня в нее не входит.
+	week := SummarizeRange(records, day(3, 0), day(3, 0).AddDate(0, 0, 7))
+	assert.Equal(suite.T(), Summarize([]TrainingResult{run, walk, walk}), week)
+
+	// from входит в диапазон, to — нет.
+	partial := SummarizeRange(records, day(3, 19), day(10, 7))
+	assert.Equal(suite.T(), 2, partial.Count)
+	assert.Equal(suite.T(), map[string]int{"Ходьба": 2}, partial.CountByActivity)
+
+	assert.Equal(suite.T(), DaySummary{}, SummarizeRange(records, day(11, 0), day(12, 0)))
+}
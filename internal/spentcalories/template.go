@@ -0,0 +1,129 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// Встроенные шаблоны отчета о тренировке для TrainingInfoWithFormat.
+var (
+	// TrainingTemplateRU — отчет TrainingInfo. По нему же выводятся отчеты
+	// TrainingInfoUnits, TrainingInfoEnergy и TrainingInfoOpts.
+	TrainingTemplateRU = template.Must(template.New("ru").Parse(
+		"Тип тренировки: {{.Activity}}\n" +
+			"Длительность: {{.Duration}} ч.\n" +
+			"{{if .Distance}}Дистанция: {{.Distance}} {{if .Imperial}}mi.{{else}}км.{{end}}\n{{end}}" +
+			"{{if .Speed}}Скорость: {{.Speed}} {{if .Imperial}}mph{{else}}км/ч{{end}}\n{{end}}" +
+			"{{if .Pace}}Темп: {{.Pace}} {{if .Imperial}}мин/mi{{else}}мин/км{{end}}\n{{end}}" +
+			"{{if .Cadence}}Каденс: {{.Cadence}} шаг/мин\n{{end}}" +
+			"{{if .Energy}}Затрачено энергии: {{.Energy}} кДж\n{{else}}Сожгли калорий: {{.Calories}}\n{{end}}" +
+			"{{if .Terrain}}Покрытие: {{.Terrain}} (x{{.TerrainMultiplier}})\n{{end}}" +
+			"{{if .HeartRate}}Средний пульс: {{.HeartRate}} уд/мин\n{{end}}",
+	))
+
+	// TrainingTemplateEN — тот же отчет на английском языке.
+	TrainingTemplateEN = template.Must(template.New("en").Parse(
+		"Activity type: {{.Activity}}\n" +
+			"Duration: {{.Duration}} h\n" +
+			"{{if .Distance}}Distance: {{.Distance}} {{if .Imperial}}mi{{else}}km{{end}}\n{{end}}" +
+			"{{if .Speed}}Speed: {{.Speed}} {{if .Imperial}}mph{{else}}km/h{{end}}\n{{end}}" +
+			"{{if .Pace}}Pace: {{.Pace}} {{if .Imperial}}min/mi{{else}}min/km{{end}}\n{{end}}" +
+			"{{if .Cadence}}Cadence: {{.Cadence}} spm\n{{end}}" +
+			"{{if .Energy}}Energy burned: {{.Energy}} kJ\n{{else}}Calories burned: {{.Calories}}\n{{end}}" +
+			"{{if .Terrain}}Terrain: {{.Terrain}} (x{{.TerrainMultiplier}})\n{{end}}" +
+			"{{if .HeartRate}}Average heart rate: {{.HeartRate}} bpm\n{{end}}",
+	))
+)
+
+// trainingView — данные тренировки для шаблона. Числа уже отформатированы,
+// по умолчанию по DefaultFormat, как в TrainingInfo, чтобы вывод не зависел от шаблона.
+type trainingView struct {
+	Activity          string
+	Duration          string // в часах.
+	Distance          string // в км или милях, пустая строка — строка скрыта.
+	Speed             string // в км/ч или милях в час, пустая строка — строка скрыта.
+	Pace              string // минуты и секунды на км или милю, пустая строка — нулевая дистанция.
+	Cadence           string // в шагах в минуту, пустая строка — тренировка без шагов.
+	Calories          string
+	Energy            string // в килоджоулях, пустая строка — энергия выводится в ккал.
+	Terrain           string // пустая строка — ровная дорога.
+	TerrainMultiplier string
+	HeartRate         int  // средний пульс, 0 — калории посчитаны по скорости.
+	Imperial          bool // дистанция, скорость и темп в милях.
+}
+
+// TrainingInfoWithFormat — то же, что TrainingInfo, но отчет выводится
+// по шаблону tmpl, например TrainingTemplateEN. В шаблоне доступны поля
+// Activity, Duration, Distance, Speed, Pace, Cadence, Calories, Terrain,
+// TerrainMultiplier и HeartRate; числа отформатированы с двумя знаками после запятой,
+// темп — как минуты и секунды. Поля Energy и Imperial здесь всегда пустые:
+// их заполняют отчеты в килоджоулях и милях.
+func TrainingInfoWithFormat(data string, weight, height float64, tmpl *template.Template) (string, error) {
+	t, err := ParseTrainingData(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	return t.render(tmpl)
+}
+
+// render выводит тренировку t по шаблону tmpl.
+func (t Training) render(tmpl *template.Template) (string, error) {
+	return execute(tmpl, t.view(Metric, Kilocalories, defaultReport))
+}
+
+// view возвращает данные тренировки t для шаблона: дистанция, скорость
+// и темп — в системе единиц units, энергия — в единицах energy,
+// числа и набор строк — по настройкам r.
+func (t Training) view(units UnitSystem, energy EnergyUnit, r ReportOptions) trainingView {
+	opts := r.Format
+	dist, speed, pace := t.inUnits(units)
+
+	v := trainingView{
+		Activity: t.Activity,
+		Duration: opts.FormatFloat(t.Duration.Hours()),
+		Calories: opts.FormatFloat(t.Calories),
+		Terrain:  t.Terrain,
+
+		HeartRate: t.HeartRate,
+		Imperial:  units == Imperial,
+	}
+
+	if !r.HideDistance {
+		v.Distance = opts.FormatFloat(dist)
+	}
+
+	if !r.HideSpeed {
+		v.Speed = opts.FormatFloat(speed)
+	}
+
+	if pace > 0 {
+		v.Pace = formatPace(pace)
+	}
+
+	if t.Steps > 0 {
+		v.Cadence = opts.FormatFloat(t.Cadence)
+	}
+
+	if energy == Kilojoules {
+		v.Energy = opts.FormatFloat(KcalToKJ(t.Calories))
+	}
+
+	if t.Terrain != "" {
+		multiplier, _ := TerrainMultiplier(t.Terrain)
+		v.TerrainMultiplier = opts.FormatFloat(multiplier)
+	}
+
+	return v
+}
+
+// execute выполняет шаблон tmpl на данных v.
+func execute(tmpl *template.Template, v trainingView) (string, error) {
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, v); err != nil {
+		return "", fmt.Errorf("execute template: %w", err)
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,31 @@
+package spentcalories
+
+import (
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoWithFormat() {
+	for _, data := range []string{"6000,Бег,1h00m", "6000,Ходьба,1h00m,terrain=snow"} {
+		want, err := TrainingInfo(data, 75.0, 1.75)
+		assert.NoError(suite.T(), err)
+
+		got, err := TrainingInfoWithFormat(data, 75.0, 1.75, TrainingTemplateRU)
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), want, got)
+	}
+
+	got, err := TrainingInfoWithFormat("6000,Бег,1h00m", 75.0, 1.75, TrainingTemplateEN)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Activity type: Бег\nDuration: 1.00 h\nDistance: 4.72 km\nSpeed: 4.72 km/h\nPace: 12:42 min/km\nCadence: 100.00 spm\nCalories burned: 354.38\n", got)
+
+	custom := template.Must(template.New("kk").Parse("{{.Activity}}: {{.Calories}} ккал\n"))
+	got, err = TrainingInfoWithFormat("6000,Бег,1h00m", 75.0, 1.75, custom)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Бег: 354.38 ккал\n", got)
+
+	got, err = TrainingInfoWithFormat("6000,Йога,1h00m", 75.0, 1.75, TrainingTemplateEN)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+	assert.Empty(suite.T(), got)
+}
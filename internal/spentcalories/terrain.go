@@ -0,0 +1,38 @@
+package spentcalories
+
+import (
+	"fmt"
+	"strings"
+)
+
+// terrains — поддерживаемые типы покрытия в порядке возрастания нагрузки.
+var terrains = []string{"pavement", "trail", "sand", "snow"}
+
+// terrainMultipliers — во сколько раз ходьба по покрытию тяжелее,
+// чем по ровному асфальту.
+var terrainMultipliers = map[string]float64{
+	"pavement": 1.0, // асфальт.
+	"trail":    1.1, // грунтовая тропа.
+	"sand":     1.5, // песок.
+	"snow":     1.6, // снег.
+}
+
+// TerrainError возвращается для неизвестного типа покрытия.
+type TerrainError struct {
+	Value string // переданный тип покрытия.
+}
+
+func (e *TerrainError) Error() string {
+	return fmt.Sprintf("unknown terrain %q, accepted: %s", e.Value, strings.Join(terrains, ", "))
+}
+
+// TerrainMultiplier возвращает коэффициент, на который умножаются калории
+// при ходьбе по указанному покрытию. На дистанцию и скорость он не влияет.
+func TerrainMultiplier(terrain string) (float64, error) {
+	multiplier, ok := terrainMultipliers[terrain]
+	if !ok {
+		return 0, &TerrainError{Value: terrain}
+	}
+
+	return multiplier, nil
+}
@@ -0,0 +1,80 @@
+package spentcalories
+
+import (
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestTerrainMultiplier() {
+	tests := []struct {
+		terrain string
+		want    float64
+	}{
+		{terrain: "pavement", want: 1.0},
+		{terrain: "trail", want: 1.1},
+		{terrain: "sand", want: 1.5},
+		{terrain: "snow", want: 1.6},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.terrain, func() {
+			got, err := TerrainMultiplier(tt.terrain)
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+
+	_, err := TerrainMultiplier("lava")
+	var terrainErr *TerrainError
+	assert.ErrorAs(suite.T(), err, &terrainErr)
+	assert.Contains(suite.T(), err.Error(), "pavement, trail, sand, snow")
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoTerrain() {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:  "снег - калории в 1.6 раза больше, дистанция и скорость прежние",
+			input: "6000,Ходьба,1h00m,terrain=snow",
+			want:  "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 283.50\nПокрытие: snow (x1.60)\n",
+		},
+		{
+			name:  "песок",
+			input: "6000,Ходьба,1h00m,terrain=sand",
+			want:  "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 4.72 км.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 265.78\nПокрытие: sand (x1.50)\n",
+		},
+		{
+			name:    "неизвестное покрытие",
+			input:   "6000,Ходьба,1h00m,terrain=lava",
+			wantErr: true,
+		},
+		{
+			name:    "покрытие для бега",
+			input:   "6000,Бег,1h00m,terrain=snow",
+			wantErr: true,
+		},
+		{
+			name:    "неизвестное поле",
+			input:   "6000,Ходьба,1h00m,slope=5",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfo(tt.input, 75.0, 1.75)
+
+			if tt.wantErr {
+				assert.Error(suite.T(), err)
+				assert.Empty(suite.T(), got)
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.Equal(suite.T(), tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package spentcalories
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Training — результат расчета одной тренировки.
+//
+// Значения не округляются, округление выполняется только при выводе.
+type Training struct {
+	Steps    int           // количество шагов, для велосипеда и плавания — 0.
+	Activity string        // вид активности.
+	Duration time.Duration // продолжительность тренировки.
+	Distance float64       // дистанция в километрах.
+	Speed    float64       // средняя скорость в км/ч.
+	Pace     time.Duration // средний темп — время на один километр.
+	Cadence  float64       // каденс в шагах в минуту, для велосипеда и плавания — 0.
+	Calories float64       // количество потраченных калорий.
+	Terrain  string        // тип покрытия для ходьбы, пустая строка — ровная дорога.
+
+	// HeartRate — средний пульс, если калории посчитаны по нему, иначе 0.
+	HeartRate int
+}
+
+// DurationHours возвращает продолжительность тренировки в часах.
+func (t Training) DurationHours() float64 {
+	return t.Duration.Hours()
+}
+
+// DistanceKm возвращает дистанцию в километрах.
+func (t Training) DistanceKm() float64 {
+	return t.Distance
+}
+
+// SpeedKmh возвращает среднюю скорость в км/ч.
+func (t Training) SpeedKmh() float64 {
+	return t.Speed
+}
+
+// TrainingResult — другое имя Training для кода, который ожидает результат
+// TrainingInfo в виде структуры.
+type TrainingResult = Training
+
+// String возвращает информацию о тренировке в формате:
+//
+//	Тип тренировки: Ходьба
+//	Длительность: 1.00 ч.
+//	Дистанция: 4.72 км.
+//	Скорость: 4.72 км/ч
+//	Темп: 12:42 мин/км
+//	Каденс: 100.00 шаг/мин
+//	Сожгли калорий: 177.19
+//
+// Строка с темпом выводится только для ненулевой дистанции,
+// строка с каденсом — только для тренировок с шагами. Если калории
+// посчитаны по пульсу, в конце добавляется строка со средним пульсом.
+func (t Training) String() string {
+	return t.format(Metric, Kilocalories, defaultReport)
+}
+
+// format возвращает информацию о тренировке, где дистанция и скорость
+// выводятся в системе единиц units, энергия — в единицах energy,
+// а числа и набор строк — по настройкам r. Полный отчет выводится
+// по шаблону TrainingTemplateRU, поэтому совпадает с TrainingInfoWithFormat.
+func (t Training) format(units UnitSystem, energy EnergyUnit, r ReportOptions) string {
+	if r.Compact {
+		return t.formatCompact(units, energy, r)
+	}
+
+	info, err := execute(TrainingTemplateRU, t.view(units, energy, r))
+	if err != nil {
+		// встроенный шаблон выполняется на trainingView без ошибок.
+		return ""
+	}
+
+	return info
+}
+
+// inUnits возвращает дистанцию, скорость и темп в системе единиц units.
+func (t Training) inUnits(units UnitSystem) (dist, speed float64, pace time.Duration) {
+	if units == Imperial {
+		return t.Distance / kmInMile, t.Speed / kmInMile, time.Duration(float64(t.Pace) * kmInMile)
+	}
+
+	return t.Distance, t.Speed, t.Pace
+}
+
+// formatCompact возвращает информацию о тренировке одной строкой:
+//
+//	Ходьба: 1.00 ч., 4.72 км., 4.72 км/ч, 177.19 ккал
+func (t Training) formatCompact(units UnitSystem, energy EnergyUnit, r ReportOptions) string {
+	dist, speed, _ := t.inUnits(units)
+
+	distUnit, speedUnit := "км.", "км/ч"
+	if units == Imperial {
+		distUnit, speedUnit = "mi.", "mph"
+	}
+
+	fields := []string{r.Format.FormatFloat(t.Duration.Hours()) + " ч."}
+	if !r.HideDistance {
+		fields = append(fields, r.Format.FormatFloat(dist)+" "+distUnit)
+	}
+
+	if !r.HideSpeed {
+		fields = append(fields, r.Format.FormatFloat(speed)+" "+speedUnit)
+	}
+
+	fields = append(fields, energy.compact(t.Calories, r.Format))
+
+	return t.Activity + ": " + strings.Join(fields, ", ") + "\n"
+}
+
+// formatPace форматирует темп как минуты и секунды: 5:42.
+func formatPace(pace time.Duration) string {
+	seconds := int(math.Round(pace.Seconds()))
+	return fmt.Sprintf("%d:%02d", seconds/60, seconds%60)
+}
@@ -0,0 +1,107 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestParseTrainingData() {
+	got, err := ParseTrainingData("6000,Ходьба,1h00m", 75.0, 1.75)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 6000, got.Steps)
+	assert.Equal(suite.T(), "Ходьба", got.Activity)
+	assert.Equal(suite.T(), time.Hour, got.Duration)
+	assert.Equal(suite.T(), 4.725, got.Distance)
+	assert.Equal(suite.T(), 4.725, got.Speed)
+	assert.InDelta(suite.T(), 177.1875, got.Calories, 1e-9)
+
+	got, err = ParseTrainingData("25400,Велоспорт,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 0, got.Steps)
+	assert.InDelta(suite.T(), 25.4, got.Distance, 1e-9)
+
+	_, err = ParseTrainingData("6000,Йога,1h00m", 75.0, 1.75)
+	assert.ErrorContains(suite.T(), err, "неизвестный тип тренировки")
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingString() {
+	t := Training{
+		Steps:    3000,
+		Activity: "Бег",
+		Duration: 30 * time.Minute,
+		Distance: 2.3625,
+		Speed:    4.725,
+		Pace:     761904761904 * time.Nanosecond,
+		Cadence:  100,
+		Calories: 177.1875,
+	}
+
+	want := "Тип тренировки: Бег\nДлительность: 0.50 ч.\nДистанция: 2.36 км.\nСкорость: 4.72 км/ч\nТемп: 12:42 мин/км\nКаденс: 100.00 шаг/мин\nСожгли калорий: 177.19\n"
+	assert.Equal(suite.T(), want, t.String())
+
+	got, err := TrainingInfo("3000,Бег,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoResult() {
+	got, err := TrainingInfoResult("6000,Бег,1h00m", 75.0, 1.75)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Бег", got.Activity)
+	assert.Equal(suite.T(), time.Hour, got.Duration)
+	assert.InDelta(suite.T(), 354.375, got.Calories, 1e-9)
+	assert.Equal(suite.T(), 1.0, got.DurationHours())
+	assert.Equal(suite.T(), got.Distance, got.DistanceKm())
+	assert.Equal(suite.T(), got.Speed, got.SpeedKmh())
+
+	info, err := TrainingInfo("6000,Бег,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), info, got.String())
+
+	_, err = TrainingInfoResult("6000,Бег", 75.0, 1.75)
+	assert.ErrorContains(suite.T(), err, "parseTraining")
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingStats() {
+	got, err := TrainingStats("3000,Ходьба,30m", 75.0, 1.75)
+
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Ходьба", got.Activity)
+	assert.Equal(suite.T(), 0.5, got.DurationHours())
+	assert.InDelta(suite.T(), 2.3625, got.DistanceKm(), 1e-9)
+	assert.InDelta(suite.T(), 4.725, got.SpeedKmh(), 1e-9)
+	assert.InDelta(suite.T(), 88.59375, got.Calories, 1e-9)
+
+	want, err := TrainingInfoResult("3000,Ходьба,30m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	_, err = TrainingStats("3000,Йога,30m", 75.0, 1.75)
+	assert.ErrorIs(suite.T(), err, ErrUnknownActivity)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingStringPace() {
+	t := Training{Activity: "Бег", Duration: 30 * time.Minute, Distance: 5.263, Pace: 342 * time.Second}
+	assert.Contains(suite.T(), t.String(), "Темп: 5:42 мин/км\n")
+
+	t.Pace = 5*time.Minute + 2*time.Second
+	assert.Contains(suite.T(), t.String(), "Темп: 5:02 мин/км\n")
+
+	t.Distance, t.Pace = 0, 0
+	assert.NotContains(suite.T(), t.String(), "Темп")
+}
+
+func (suite *SpentCaloriesTestSuite) TestPace() {
+	got, err := Pace(10, 57*time.Minute)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), 342*time.Second, got)
+
+	_, err = Pace(0, time.Hour)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDistance)
+
+	_, err = Pace(10, 0)
+	assert.ErrorIs(suite.T(), err, ErrNonPositiveDuration)
+}
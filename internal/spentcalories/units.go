@@ -0,0 +1,157 @@
+package spentcalories
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Единицы измерения, которые используются в расчетах.
+//
+// Отдельные типы не дают перепутать вес и рост при вызове функций:
+// компилятор не позволит передать Metres туда, где ожидаются Kilograms.
+type (
+	Kilograms float64 // вес в килограммах.
+	Metres    float64 // рост или длина в метрах.
+	Kcal      float64 // энергия в килокалориях.
+	Km        float64 // дистанция в километрах.
+)
+
+// Константы для перевода из других единиц измерения.
+const (
+	kgInLb   = 0.45359237 // количество килограммов в фунте.
+	mInFt    = 0.3048     // количество метров в футе.
+	kmInMile = 1.609344   // количество километров в миле.
+	cmInM    = 100        // количество сантиметров в метре.
+
+	minHeight = 0.9 // минимальный допустимый рост в метрах.
+	maxHeight = 2.5 // максимальный допустимый рост в метрах.
+)
+
+// FromPounds переводит вес из фунтов в килограммы.
+func FromPounds(lb float64) Kilograms {
+	return Kilograms(lb * kgInLb)
+}
+
+// FromFeet переводит рост из футов в метры.
+func FromFeet(ft float64) Metres {
+	return Metres(ft * mInFt)
+}
+
+// FromCentimetres переводит рост из сантиметров в метры.
+func FromCentimetres(cm float64) Metres {
+	return Metres(cm / cmInM)
+}
+
+// HeightError возвращается, если рост вне допустимого диапазона.
+type HeightError struct {
+	Value float64 // переданное значение роста.
+
+	// Centimetres — допускался ли рост и в сантиметрах, как в NormalizeHeight.
+	Centimetres bool
+}
+
+func (e *HeightError) Error() string {
+	if !e.Centimetres {
+		return fmt.Sprintf("height %g is out of range: expected %g-%g metres", e.Value, minHeight, maxHeight)
+	}
+
+	return fmt.Sprintf(
+		"height %g is out of range: expected %g-%g metres or %g-%g centimetres",
+		e.Value, minHeight, maxHeight, minHeight*cmInM, maxHeight*cmInM,
+	)
+}
+
+// NormalizeHeight приводит рост к метрам.
+//
+// Значения от 0.9 до 2.5 считаются метрами и возвращаются как есть,
+// значения от 90 до 250 — сантиметрами и переводятся в метры.
+//
+// Возвращает:
+// Metres — рост в метрах.
+// bool — true, если значение было переведено из сантиметров.
+// error — *HeightError, если значение не подходит ни под одну из единиц.
+func NormalizeHeight(h float64) (Metres, bool, error) {
+	if m, err := ValidateHeight(h); err == nil {
+		return m, false, nil
+	}
+
+	if h >= minHeight*cmInM && h <= maxHeight*cmInM {
+		return FromCentimetres(h), true, nil
+	}
+
+	return 0, false, &HeightError{Value: h, Centimetres: true}
+}
+
+// ValidateHeight проверяет, что рост задан в метрах, без автоматического
+// перевода из сантиметров. Подходит для API, которые гарантируют метры.
+func ValidateHeight(h float64) (Metres, error) {
+	if h < minHeight || h > maxHeight {
+		return 0, &HeightError{Value: h}
+	}
+
+	return Metres(h), nil
+}
+
+// UnitSystem — система единиц, в которой передаются вес и рост
+// и выводятся дистанция и скорость.
+type UnitSystem int
+
+const (
+	Metric   UnitSystem = iota // килограммы, метры, километры.
+	Imperial                   // фунты, футы, мили.
+)
+
+// toMetric переводит вес и рост из системы единиц u в метрическую.
+func (u UnitSystem) toMetric(weight, height float64) (Kilograms, Metres, error) {
+	switch u {
+	case Metric:
+		return Kilograms(weight), Metres(height), nil
+	case Imperial:
+		return FromPounds(weight), FromFeet(height), nil
+	default:
+		return 0, 0, errors.New("unknown unit system")
+	}
+}
+
+// RunningSpentCaloriesInUnits — то же, что RunningCalories, но вес и рост
+// передаются в системе единиц units: килограммы и метры или фунты и футы.
+func RunningSpentCaloriesInUnits(steps int, weight, height float64, duration time.Duration, units UnitSystem) (float64, error) {
+	kg, m, err := units.toMetric(weight, height)
+	if err != nil {
+		return 0.0, err
+	}
+
+	calories, err := RunningCalories(steps, kg, m, duration)
+	return float64(calories), err
+}
+
+// WalkingSpentCaloriesInUnits — то же, что WalkingCalories, но вес и рост
+// передаются в системе единиц units: килограммы и метры или фунты и футы.
+func WalkingSpentCaloriesInUnits(steps int, weight, height float64, duration time.Duration, units UnitSystem) (float64, error) {
+	kg, m, err := units.toMetric(weight, height)
+	if err != nil {
+		return 0.0, err
+	}
+
+	calories, err := WalkingCalories(steps, kg, m, duration)
+	return float64(calories), err
+}
+
+// TrainingInfoUnits — то же, что TrainingInfo, но вес и рост передаются
+// в системе единиц units, а для Imperial дистанция выводится в милях,
+// скорость — в милях в час. Калории всегда считаются в метрических единицах,
+// а функции без параметра units по-прежнему работают в метрической системе.
+func TrainingInfoUnits(data string, weight, height float64, units UnitSystem) (string, error) {
+	kg, m, err := units.toMetric(weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	t, err := ParseTrainingData(data, float64(kg), float64(m))
+	if err != nil {
+		return "", err
+	}
+
+	return t.format(units, Kilocalories, defaultReport), nil
+}
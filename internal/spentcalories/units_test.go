@@ -0,0 +1,150 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestFromPounds() {
+	assert.InDelta(suite.T(), 75.0, float64(FromPounds(165.347)), 0.001)
+	assert.Equal(suite.T(), Kilograms(0), FromPounds(0))
+}
+
+func (suite *SpentCaloriesTestSuite) TestFromCentimetres() {
+	assert.InDelta(suite.T(), 1.75, float64(FromCentimetres(175)), 1e-9)
+	assert.InDelta(suite.T(), 1.85, float64(FromCentimetres(185)), 1e-9)
+	assert.InDelta(suite.T(), 1.78, float64(FromCentimetres(178)), 1e-9)
+}
+
+func (suite *SpentCaloriesTestSuite) TestNormalizeHeightBeforeTrainingInfo() {
+	want, err := TrainingInfo("6000,Бег,1h00m", 75.0, 1.78)
+	assert.NoError(suite.T(), err)
+
+	// рост в сантиметрах переводится, а рост в метрах не переводится повторно.
+	for _, height := range []float64{178, 1.78} {
+		h, _, err := NormalizeHeight(height)
+		assert.NoError(suite.T(), err)
+		assert.InDelta(suite.T(), 1.78, float64(h), 1e-9)
+
+		got, err := TrainingInfo("6000,Бег,1h00m", 75.0, float64(h))
+		assert.NoError(suite.T(), err)
+		assert.Equal(suite.T(), want, got)
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTypedCaloriesMatchFloatWrappers() {
+	running, err := RunningCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+
+	legacyRunning, err := RunningSpentCalories(6000, 75.0, 1.75, time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), legacyRunning, float64(running))
+
+	walking, err := WalkingCalories(6000, FromPounds(165.347), FromCentimetres(175), time.Hour)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), 177.19, float64(walking), 0.01)
+
+	_, err = WalkingCalories(6000, 75.0, 0, time.Hour)
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestNormalizeHeight() {
+	tests := []struct {
+		name          string
+		height        float64
+		wantHeight    Metres
+		wantConverted bool
+		wantErr       bool
+	}{
+		{name: "метры", height: 1.75, wantHeight: 1.75},
+		{name: "сантиметры", height: 175, wantHeight: 1.75, wantConverted: true},
+		{name: "высокий рост в метрах", height: 2.3, wantHeight: 2.3},
+		{name: "высокий рост в сантиметрах", height: 230, wantHeight: 2.3, wantConverted: true},
+		{name: "ни метры, ни сантиметры", height: 55, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, converted, err := NormalizeHeight(tt.height)
+
+			if tt.wantErr {
+				var heightErr *HeightError
+				assert.ErrorAs(suite.T(), err, &heightErr)
+				assert.Equal(suite.T(), tt.height, heightErr.Value)
+				assert.Contains(suite.T(), err.Error(), "metres")
+				assert.Contains(suite.T(), err.Error(), "centimetres")
+				return
+			}
+
+			assert.NoError(suite.T(), err)
+			assert.InDelta(suite.T(), float64(tt.wantHeight), float64(got), 1e-9)
+			assert.Equal(suite.T(), tt.wantConverted, converted)
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestValidateHeight() {
+	got, err := ValidateHeight(1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), Metres(1.75), got)
+
+	_, err = ValidateHeight(175)
+	var heightErr *HeightError
+	assert.ErrorAs(suite.T(), err, &heightErr)
+	assert.NotContains(suite.T(), err.Error(), "centimetres")
+}
+
+func (suite *SpentCaloriesTestSuite) TestFromFeet() {
+	assert.InDelta(suite.T(), 1.8288, float64(FromFeet(6)), 1e-9)
+}
+
+func (suite *SpentCaloriesTestSuite) TestCaloriesInUnits() {
+	// один и тот же человек: 75 кг = 165.3467 фунта, 1.75 м = 5.74147 фута.
+	const (
+		weightKg = 75.0
+		heightM  = 1.75
+		weightLb = weightKg / kgInLb
+		heightFt = heightM / mInFt
+	)
+
+	metricRunning, err := RunningSpentCaloriesInUnits(6000, weightKg, heightM, time.Hour, Metric)
+	assert.NoError(suite.T(), err)
+	imperialRunning, err := RunningSpentCaloriesInUnits(6000, weightLb, heightFt, time.Hour, Imperial)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), metricRunning, imperialRunning, 1e-9)
+	assert.InDelta(suite.T(), 354.375, metricRunning, 1e-9)
+
+	metricWalking, err := WalkingSpentCaloriesInUnits(6000, weightKg, heightM, time.Hour, Metric)
+	assert.NoError(suite.T(), err)
+	imperialWalking, err := WalkingSpentCaloriesInUnits(6000, weightLb, heightFt, time.Hour, Imperial)
+	assert.NoError(suite.T(), err)
+	assert.InDelta(suite.T(), metricWalking, imperialWalking, 1e-9)
+
+	_, err = WalkingSpentCaloriesInUnits(6000, weightKg, heightM, time.Hour, UnitSystem(42))
+	assert.Error(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoUnits() {
+	metric, err := TrainingInfoUnits("6000,Ходьба,1h00m", 75.0, 1.75, Metric)
+	assert.NoError(suite.T(), err)
+
+	legacy, err := TrainingInfo("6000,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), legacy, metric)
+
+	imperial, err := TrainingInfoUnits("6000,Ходьба,1h00m", 75.0/kgInLb, 1.75/mInFt, Imperial)
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), "Тип тренировки: Ходьба\nДлительность: 1.00 ч.\nДистанция: 2.94 mi.\nСкорость: 2.94 mph\nТемп: 20:26 мин/mi\nКаденс: 100.00 шаг/мин\nСожгли калорий: 177.19\n", imperial)
+}
+
+func (suite *SpentCaloriesTestSuite) TestImperialConversionFactors() {
+	// 1 км = 0.621371 мили, 1 кг = 2.20462 фунта.
+	assert.InDelta(suite.T(), 0.621371, 1/kmInMile, 1e-6)
+	assert.InDelta(suite.T(), 2.20462, 1/kgInLb, 1e-5)
+
+	got, err := TrainingInfoUnits("20000,Бег,1h00m", 165.347, 5.74147, Imperial)
+	assert.NoError(suite.T(), err)
+	assert.Contains(suite.T(), got, "Дистанция: 9.79 mi.\n")
+	assert.Contains(suite.T(), got, "Скорость: 9.79 mph\n")
+}
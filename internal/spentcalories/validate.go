@@ -0,0 +1,120 @@
+package spentcalories
+
+import (
+	"fmt"
+	"maps"
+	"time"
+)
+
+// maxPlausibleSpeed — максимальная правдоподобная средняя скорость в км/ч
+// для вида активности. Более высокая скорость скорее говорит о сбое датчика.
+// Из этой же таблицы NewDefaultLimits берет границы для велосипеда и плавания.
+var maxPlausibleSpeed = map[string]float64{
+	"Бег":       45,
+	"Ходьба":    15,
+	"Велоспорт": 80,
+	"Велосипед": 80,
+	"Плавание":  10,
+}
+
+// ValidateTraining принимает:
+// data string — строку с данными в формате TrainingInfo.
+// weight, height float64 — вес (кг.) и рост (м.) пользователя.
+//
+// Проверяет, что результат тренировки физически правдоподобен, например
+// что скорость ходьбы не выше 15 км/ч, а бега — не выше 45 км/ч.
+// Расчет при этом не прерывается: ValidateTraining только сообщает о подозрительных
+// значениях, а если данные не удалось разобрать, возвращает ошибку как предупреждение.
+//
+// Возвращает:
+// []string — предупреждения или пустой список, если все выглядит нормально.
+func ValidateTraining(data string, weight, height float64) []string {
+	t, err := ParseTrainingData(data, weight, height)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	var warnings []string
+
+	activity := canonicalActivity(t.Activity)
+	if limit, ok := maxPlausibleSpeed[activity]; ok && t.Speed > limit {
+		warnings = append(warnings, fmt.Sprintf(
+			"implausible %s speed %.2f km/h: expected at most %g km/h",
+			activity, t.Speed, limit,
+		))
+	}
+
+	return warnings
+}
+
+// Limits — границы правдоподобных значений для TrainingInfoWithLimits.
+// Нулевое поле означает, что соответствующая проверка не выполняется.
+// MaxSteps проверяется дополнительно к общей границе MaxSteps пакета,
+// поэтому имеет смысл, только если он меньше ее.
+type Limits struct {
+	MaxSteps    int                // максимальное количество шагов в записи.
+	MaxDuration time.Duration      // максимальная продолжительность тренировки.
+	MaxSpeed    map[string]float64 // максимальная скорость в км/ч по видам активности.
+}
+
+// Границы скорости по умолчанию для TrainingInfoWithLimits в км/ч. Они строже,
+// чем в ValidateTraining: тренировка с такой скоростью не считается вовсе.
+const (
+	defaultMaxWalkingSpeed = 10
+	defaultMaxRunningSpeed = 30
+)
+
+// NewDefaultLimits возвращает границы по умолчанию: до 100000 шагов,
+// до 24 часов, ходьба до 10 км/ч, бег до 30 км/ч, а велосипед и плавание —
+// из той же таблицы, что и в ValidateTraining.
+// Каждый вызов возвращает новую карту MaxSpeed, поэтому для колясочников,
+// ультрамарафонцев и других особых случаев результат можно менять,
+// не затрагивая других вызывающих.
+func NewDefaultLimits() Limits {
+	speed := maps.Clone(maxPlausibleSpeed)
+	speed["Ходьба"] = defaultMaxWalkingSpeed
+	speed["Бег"] = defaultMaxRunningSpeed
+
+	return Limits{
+		MaxSteps:    100000,
+		MaxDuration: 24 * time.Hour,
+		MaxSpeed:    speed,
+	}
+}
+
+// Check проверяет тренировку t и возвращает ошибку, обернутую
+// в ErrImplausible, если какое-то значение выходит за границы l.
+// Слишком большое количество шагов дополнительно оборачивается в ErrTooManySteps.
+func (l Limits) Check(t Training) error {
+	if l.MaxSteps > 0 && t.Steps > l.MaxSteps {
+		return fmt.Errorf("%w: %w: %d steps, expected at most %d", ErrImplausible, ErrTooManySteps, t.Steps, l.MaxSteps)
+	}
+
+	if l.MaxDuration > 0 && t.Duration > l.MaxDuration {
+		return fmt.Errorf("%w: duration %v, expected at most %v", ErrImplausible, t.Duration, l.MaxDuration)
+	}
+
+	activity := canonicalActivity(t.Activity)
+	if limit, ok := l.MaxSpeed[activity]; ok && limit > 0 && t.Speed > limit {
+		return fmt.Errorf("%w: %s speed %.2f km/h, expected at most %g km/h", ErrImplausible, activity, t.Speed, limit)
+	}
+
+	return nil
+}
+
+// TrainingInfoWithLimits — то же, что TrainingInfo, но вместо заведомо
+// неправдоподобного результата, например "2000000,Ходьба,1m",
+// возвращает ошибку, обернутую в ErrImplausible. Границы задаются limits,
+// обычно NewDefaultLimits().
+func TrainingInfoWithLimits(data string, weight, height float64, limits Limits) (string, error) {
+	t, err := ParseTrainingData(data, weight, height)
+	if err != nil {
+		return "", err
+	}
+
+	if err := limits.Check(t); err != nil {
+		return "", err
+	}
+
+	return t.String(), nil
+}
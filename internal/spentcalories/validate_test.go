@@ -0,0 +1,88 @@
+package spentcalories
+
+import (
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func (suite *SpentCaloriesTestSuite) TestValidateTraining() {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{name: "обычная ходьба", input: "6000,Ходьба,1h00m"},
+		{name: "обычный бег", input: "12000,Running,1h00m"},
+		{name: "ходьба за секунду", input: "3456,Ходьба,1s", want: []string{"implausible Ходьба speed 9797.76 km/h: expected at most 15 km/h"}},
+		{name: "бег за минуту", input: "3456,Бег,1m", want: []string{"implausible Бег speed 163.30 km/h: expected at most 45 km/h"}},
+		{name: "некорректные данные", input: "3456,Ходьба", want: []string{"parseTraining: bad data format"}},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			assert.Equal(suite.T(), tt.want, ValidateTraining(tt.input, 75.0, 1.75))
+		})
+	}
+}
+
+func (suite *SpentCaloriesTestSuite) TestTrainingInfoWithLimits() {
+	want, err := TrainingInfo("6000,Ходьба,1h00m", 75.0, 1.75)
+	assert.NoError(suite.T(), err)
+
+	got, err := TrainingInfoWithLimits("6000,Ходьба,1h00m", 75.0, 1.75, NewDefaultLimits())
+	assert.NoError(suite.T(), err)
+	assert.Equal(suite.T(), want, got)
+
+	tests := []struct {
+		name    string
+		input   string
+		wantErr string
+	}{
+		{name: "слишком много шагов", input: "150000,Ходьба,24h", wantErr: "150000 steps, expected at most 100000"},
+		{name: "слишком долго", input: "60000,Ходьба,25h", wantErr: "duration 25h0m0s, expected at most 24h0m0s"},
+		{name: "слишком быстрая ходьба", input: "20000,Ходьба,1h00m", wantErr: "Ходьба speed 15.75 km/h, expected at most 10 km/h"},
+		{name: "ходьба 14 км/ч", input: "17600,Ходьба,1h00m", wantErr: "Ходьба speed 13.86 km/h, expected at most 10 km/h"},
+		{name: "слишком быстрый бег", input: "50000,Бег,1h00m", wantErr: "Бег speed 39.38 km/h, expected at most 30 km/h"},
+	}
+
+	for _, tt := range tests {
+		suite.Run(tt.name, func() {
+			got, err := TrainingInfoWithLimits(tt.input, 75.0, 1.75, NewDefaultLimits())
+			assert.ErrorIs(suite.T(), err, ErrImplausible)
+			assert.ErrorContains(suite.T(), err, tt.wantErr)
+			assert.Empty(suite.T(), got)
+		})
+	}
+
+	// ультрамарафонец: бег дольше суток без ограничения скорости.
+	ultra := Limits{MaxSteps: 300000, MaxDuration: 48 * time.Hour}
+	_, err = TrainingInfoWithLimits("180000,Бег,30h", 75.0, 1.75, ultra)
+	assert.NoError(suite.T(), err)
+
+	// больше MaxSteps шагов не пропускают никакие Limits.
+	_, err = TrainingInfoWithLimits("250000,Бег,30h", 75.0, 1.75, ultra)
+	assert.ErrorIs(suite.T(), err, ErrTooManySteps)
+
+	_, err = TrainingInfoWithLimits("20000,Ходьба,1h00m", 75.0, 1.75, Limits{})
+	assert.NoError(suite.T(), err)
+}
+
+func (suite *SpentCaloriesTestSuite) TestNewDefaultLimits() {
+	limits := NewDefaultLimits()
+	assert.Equal(suite.T(), 10.0, limits.MaxSpeed["Ходьба"])
+	assert.Equal(suite.T(), 30.0, limits.MaxSpeed["Бег"])
+	assert.Equal(suite.T(), maxPlausibleSpeed["Велоспорт"], limits.MaxSpeed["Велоспорт"])
+	assert.Equal(suite.T(), maxPlausibleSpeed["Плавание"], limits.MaxSpeed["Плавание"])
+
+	// изменение копии не затрагивает границы по умолчанию.
+	limits.MaxSpeed["Ходьба"] = 25
+	delete(limits.MaxSpeed, "Бег")
+
+	assert.Equal(suite.T(), 10.0, NewDefaultLimits().MaxSpeed["Ходьба"])
+	assert.Equal(suite.T(), 30.0, NewDefaultLimits().MaxSpeed["Бег"])
+	assert.Equal(suite.T(), 15.0, maxPlausibleSpeed["Ходьба"])
+
+	_, err := TrainingInfoWithLimits("20000,Ходьба,1h00m", 75.0, 1.75, limits)
+	assert.NoError(suite.T(), err)
+}